@@ -7,7 +7,7 @@ import (
 	"net/http"
 	"os"
 
-	"github.com/sourcegraph/createsend-go/createsend"
+	"github.com/Joule-CMA/createsend-go/createsend"
 )
 
 var verbose = flag.Bool("v", false, "verbose")
@@ -25,6 +25,7 @@ func main() {
 		_, _ = fmt.Fprintln(os.Stderr, "\tlists-for-email CLIENT EMAIL")
 		_, _ = fmt.Fprintln(os.Stderr, "\tlist-subscribers LIST (active|unconfirmed|unsubscribed|bounced|deleted)")
 		_, _ = fmt.Fprintln(os.Stderr, "\tget-subscriber   LIST EMAIL")
+		_, _ = fmt.Fprintln(os.Stderr, "\tsubscriber-history LIST EMAIL")
 		_, _ = fmt.Fprintln(os.Stderr, "\tadd-subscriber   LIST EMAIL")
 		_, _ = fmt.Fprintln(os.Stderr, "\tunsubscribe      LIST EMAIL")
 		_, _ = fmt.Fprintln(os.Stderr)
@@ -73,6 +74,8 @@ func main() {
 		listSubscribers(remaining)
 	case "get-subscriber":
 		getSubscriber(remaining)
+	case "subscriber-history":
+		subscriberHistory(remaining)
 	case "add-subscriber":
 		addSubscriber(remaining)
 	case "unsubscribe":
@@ -141,6 +144,10 @@ func listSubscribers(args []string) {
 	}
 
 	listID, group := args[0], createsend.SubscriberGroup(args[1])
+	if !group.Valid() {
+		log.Printf("Unknown subscriber group %q.\n", group)
+		flag.Usage()
+	}
 	subs, err := apiclient.ListSubscribers(listID, group, nil)
 	if err != nil {
 		log.Fatalf("Error listing subcribers for list %q: %s\n", listID, err)
@@ -168,6 +175,29 @@ func getSubscriber(args []string) {
 	fmt.Printf("%+v\n", sub)
 }
 
+func subscriberHistory(args []string) {
+	if len(args) != 2 {
+		log.Println("subscriber-history takes 2 arguments.")
+		flag.Usage()
+	}
+
+	listID, email := args[0], args[1]
+	history, err := apiclient.SubscriberHistory(listID, email)
+	if err != nil {
+		log.Fatalf("Error getting history of subcriber %q for list %q: %s\n", email, listID, err)
+	}
+	if len(history) == 0 {
+		fmt.Printf("No history found for email address %q.\n", email)
+		return
+	}
+	for _, item := range history {
+		fmt.Printf("%s %q (%s)\n", item.Type, item.Name, item.ID)
+		for _, a := range item.Actions {
+			fmt.Printf("  %-20s %-12s %-16s %s\n", a.Date.Format("2006-01-02 15:04:05"), a.Event, a.IPAddress, a.Detail)
+		}
+	}
+}
+
 func addSubscriber(args []string) {
 	if len(args) != 2 {
 		log.Println("get-subscriber takes 2 arguments.")
@@ -0,0 +1,228 @@
+package createsend
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// BillingDetails represents the billing details of the authenticated account.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_your_billing_details
+// for more information.
+type BillingDetails struct {
+	Credits int
+}
+
+// BillingDetails returns the billing details of the authenticated account.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_your_billing_details
+// for more information.
+func (c *APIClient) BillingDetails() (*BillingDetails, error) {
+	return c.BillingDetailsContext(context.Background())
+}
+
+// BillingDetailsContext is like BillingDetails but uses ctx for the API request.
+func (c *APIClient) BillingDetailsContext(ctx context.Context) (*BillingDetails, error) {
+	req, err := c.NewRequestWithContext(ctx, "GET", "billingdetails.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var d BillingDetails
+	err = c.Do(req, &d)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Countries returns the countries accepted by the API, such as when creating
+// a client.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_valid_countries for
+// more information.
+func (c *APIClient) Countries() ([]string, error) {
+	return c.CountriesContext(context.Background())
+}
+
+// CountriesContext is like Countries but uses ctx for the API request.
+func (c *APIClient) CountriesContext(ctx context.Context) ([]string, error) {
+	req, err := c.NewRequestWithContext(ctx, "GET", "countries.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var countries []string
+	err = c.Do(req, &countries)
+	if err != nil {
+		return nil, err
+	}
+	return countries, nil
+}
+
+// Timezones returns the timezones accepted by the API, such as when creating a
+// client.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_valid_timezones for
+// more information.
+func (c *APIClient) Timezones() ([]string, error) {
+	return c.TimezonesContext(context.Background())
+}
+
+// TimezonesContext is like Timezones but uses ctx for the API request.
+func (c *APIClient) TimezonesContext(ctx context.Context) ([]string, error) {
+	req, err := c.NewRequestWithContext(ctx, "GET", "timezones.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var timezones []string
+	err = c.Do(req, &timezones)
+	if err != nil {
+		return nil, err
+	}
+	return timezones, nil
+}
+
+// SystemDate returns the current date and time in the account's timezone. The
+// returned time carries no location information.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_current_date for
+// more information.
+func (c *APIClient) SystemDate() (time.Time, error) {
+	return c.SystemDateContext(context.Background())
+}
+
+// SystemDateContext is like SystemDate but uses ctx for the API request.
+func (c *APIClient) SystemDateContext(ctx context.Context) (time.Time, error) {
+	req, err := c.NewRequestWithContext(ctx, "GET", "systemdate.json", nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var v struct{ SystemDate string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse("2006-01-02 15:04:05", v.SystemDate)
+}
+
+// PrimaryContact returns the email address of the administrator who is the
+// primary contact of the account.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_primary_contact for
+// more information.
+func (c *APIClient) PrimaryContact() (string, error) {
+	return c.PrimaryContactContext(context.Background())
+}
+
+// PrimaryContactContext is like PrimaryContact but uses ctx for the API request.
+func (c *APIClient) PrimaryContactContext(ctx context.Context) (string, error) {
+	req, err := c.NewRequestWithContext(ctx, "GET", "primarycontact.json", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var v struct{ EmailAddress string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return "", err
+	}
+	return v.EmailAddress, nil
+}
+
+// SetPrimaryContact makes the administrator with the given email address the
+// primary contact of the account.
+//
+// See https://www.campaignmonitor.com/api/account/#setting_primary_contact for
+// more information.
+func (c *APIClient) SetPrimaryContact(email string) error {
+	return c.SetPrimaryContactContext(context.Background(), email)
+}
+
+// SetPrimaryContactContext is like SetPrimaryContact but uses ctx for the API
+// request.
+func (c *APIClient) SetPrimaryContactContext(ctx context.Context, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := "primarycontact.json?" + url.Values{"email": {email}}.Encode()
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// APIKey returns the API key of the account identified by siteURL, username
+// and password. The request is authenticated with username and password, so
+// c must not be configured with an authenticating transport such as
+// APIKeyAuthTransport.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_your_api_key for
+// more information.
+func (c *APIClient) APIKey(siteURL, username, password string) (string, error) {
+	return c.APIKeyContext(context.Background(), siteURL, username, password)
+}
+
+// APIKeyContext is like APIKey but uses ctx for the API request.
+func (c *APIClient) APIKeyContext(ctx context.Context, siteURL, username, password string) (string, error) {
+	u := "apikey.json?" + url.Values{"siteurl": {siteURL}}.Encode()
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(username, password)
+
+	var v struct{ ApiKey string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return "", err
+	}
+	return v.ApiKey, nil
+}
+
+// ExternalSession represents the parameters needed to create a single sign-on
+// session into the Campaign Monitor application for an administrator or
+// person.
+//
+// See https://www.campaignmonitor.com/api/account/#single_sign_on for more
+// information.
+type ExternalSession struct {
+	Email        string `json:"Email"`
+	Chrome       string `json:"Chrome"`
+	Url          string `json:"Url"`
+	IntegratorID string `json:"IntegratorID"`
+	ClientID     string `json:"ClientID"`
+}
+
+// ExternalSessionURL creates a single sign-on session and returns the URL the
+// user should be sent to.
+//
+// See https://www.campaignmonitor.com/api/account/#single_sign_on for more
+// information.
+func (c *APIClient) ExternalSessionURL(session *ExternalSession) (string, error) {
+	return c.ExternalSessionURLContext(context.Background(), session)
+}
+
+// ExternalSessionURLContext is like ExternalSessionURL but uses ctx for the API
+// request.
+func (c *APIClient) ExternalSessionURLContext(ctx context.Context, session *ExternalSession) (string, error) {
+	req, err := c.NewRequestWithContext(ctx, "PUT", "externalsession.json", session)
+	if err != nil {
+		return "", err
+	}
+
+	var v struct{ SessionUrl string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return "", err
+	}
+	return v.SessionUrl, nil
+}
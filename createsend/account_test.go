@@ -0,0 +1,179 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBillingDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/billingdetails.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"Credits": 3021}`)
+	})
+
+	d, err := client.BillingDetails()
+	if err != nil {
+		t.Errorf("BillingDetails returned error: %v", err)
+	}
+
+	want := &BillingDetails{Credits: 3021}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("BillingDetails returned %+v, want %+v", d, want)
+	}
+}
+
+func TestCountries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/countries.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `["Afghanistan", "Albania", "Algeria"]`)
+	})
+
+	countries, err := client.Countries()
+	if err != nil {
+		t.Errorf("Countries returned error: %v", err)
+	}
+
+	want := []string{"Afghanistan", "Albania", "Algeria"}
+	if !reflect.DeepEqual(countries, want) {
+		t.Errorf("Countries returned %+v, want %+v", countries, want)
+	}
+}
+
+func TestTimezones(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/timezones.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `["(GMT) Casablanca", "(GMT) Coordinated Universal Time"]`)
+	})
+
+	timezones, err := client.Timezones()
+	if err != nil {
+		t.Errorf("Timezones returned error: %v", err)
+	}
+
+	want := []string{"(GMT) Casablanca", "(GMT) Coordinated Universal Time"}
+	if !reflect.DeepEqual(timezones, want) {
+		t.Errorf("Timezones returned %+v, want %+v", timezones, want)
+	}
+}
+
+func TestSystemDate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/systemdate.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"SystemDate": "2010-10-15 09:27:00"}`)
+	})
+
+	date, err := client.SystemDate()
+	if err != nil {
+		t.Errorf("SystemDate returned error: %v", err)
+	}
+
+	want := time.Date(2010, 10, 15, 9, 27, 0, 0, time.UTC)
+	if !date.Equal(want) {
+		t.Errorf("SystemDate returned %v, want %v", date, want)
+	}
+}
+
+func TestPrimaryContact(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/primarycontact.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "admin@example.com"}`)
+	})
+
+	email, err := client.PrimaryContact()
+	if err != nil {
+		t.Errorf("PrimaryContact returned error: %v", err)
+	}
+	if email != "admin@example.com" {
+		t.Errorf("PrimaryContact returned %q, want %q", email, "admin@example.com")
+	}
+}
+
+func TestSetPrimaryContact(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/primarycontact.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testQuerystring(t, r, "email=admin%2Bcm%40example.com")
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "admin+cm@example.com"}`)
+	})
+
+	err := client.SetPrimaryContact("admin+cm@example.com")
+	if err != nil {
+		t.Errorf("SetPrimaryContact returned error: %v", err)
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/apikey.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "siteurl=http%3A%2F%2Fexample.createsend.com")
+		if user, pass, ok := r.BasicAuth(); !ok || user != "alice" || pass != "pa55" {
+			t.Errorf("Basic auth = %q, %q, want %q, %q", user, pass, "alice", "pa55")
+		}
+		_, _ = fmt.Fprint(w, `{"ApiKey": "981298u298ue98u219e8u2e98u2"}`)
+	})
+
+	key, err := client.APIKey("http://example.createsend.com", "alice", "pa55")
+	if err != nil {
+		t.Errorf("APIKey returned error: %v", err)
+	}
+	if key != "981298u298ue98u219e8u2e98u2" {
+		t.Errorf("APIKey returned %q", key)
+	}
+}
+
+func TestExternalSessionURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	session := &ExternalSession{
+		Email:        "alice@example.com",
+		Chrome:       "None",
+		Url:          "/subscribers/search?search=bob@example.com",
+		IntegratorID: "a1b2c3d4e5f6",
+		ClientID:     "12ab",
+	}
+
+	mux.HandleFunc("/externalsession.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got ExternalSession
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Decoding request body returned error: %v", err)
+		}
+		if !reflect.DeepEqual(&got, session) {
+			t.Errorf("Request body = %+v, want %+v", got, session)
+		}
+		_, _ = fmt.Fprint(w, `{"SessionUrl": "https://external1.createsend.com/cd/create/ABCDEF12/DEADBEEF?url=FEEDDAD1"}`)
+	})
+
+	u, err := client.ExternalSessionURL(session)
+	if err != nil {
+		t.Errorf("ExternalSessionURL returned error: %v", err)
+	}
+	if want := "https://external1.createsend.com/cd/create/ABCDEF12/DEADBEEF?url=FEEDDAD1"; u != want {
+		t.Errorf("ExternalSessionURL returned %q, want %q", u, want)
+	}
+}
@@ -0,0 +1,164 @@
+package createsend
+
+import (
+	"context"
+	"net/url"
+)
+
+// PersonStatus is the status of an administrator or person.
+type PersonStatus string
+
+const (
+	PersonActive  PersonStatus = "Active"
+	PersonInvited PersonStatus = "Waiting to Accept the Invitation"
+)
+
+// Administrator represents an administrator of the authenticated account.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_administrators for
+// more information.
+type Administrator struct {
+	EmailAddress string
+	Name         string
+	Status       PersonStatus
+}
+
+// NewAdministrator represents an administrator to be added with
+// AddAdministrator or updated with UpdateAdministrator.
+//
+// See https://www.campaignmonitor.com/api/account/#adding_an_administrator for
+// more information.
+type NewAdministrator struct {
+	EmailAddress string
+	Name         string
+}
+
+// Administrators lists the administrators of the authenticated account.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_administrators for
+// more information.
+func (c *APIClient) Administrators() ([]*Administrator, error) {
+	return c.AdministratorsContext(context.Background())
+}
+
+// AdministratorsContext is like Administrators but uses ctx for the API request.
+func (c *APIClient) AdministratorsContext(ctx context.Context) ([]*Administrator, error) {
+	req, err := c.NewRequestWithContext(ctx, "GET", "admins.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var admins []*Administrator
+	err = c.Do(req, &admins)
+	if err != nil {
+		return nil, err
+	}
+	return admins, nil
+}
+
+// AdministratorDetails returns the details of the administrator with the given
+// email address.
+//
+// See https://www.campaignmonitor.com/api/account/#getting_administrator_details
+// for more information.
+func (c *APIClient) AdministratorDetails(email string) (*Administrator, error) {
+	return c.AdministratorDetailsContext(context.Background(), email)
+}
+
+// AdministratorDetailsContext is like AdministratorDetails but uses ctx for the
+// API request.
+func (c *APIClient) AdministratorDetailsContext(ctx context.Context, email string) (*Administrator, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u := "admins.json?" + url.Values{"email": {email}}.Encode()
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var admin Administrator
+	err = c.Do(req, &admin)
+	if err != nil {
+		return nil, err
+	}
+	return &admin, nil
+}
+
+// AddAdministrator invites a new administrator to the authenticated account
+// and returns their email address.
+//
+// See https://www.campaignmonitor.com/api/account/#adding_an_administrator for
+// more information.
+func (c *APIClient) AddAdministrator(admin NewAdministrator) (string, error) {
+	return c.AddAdministratorContext(context.Background(), admin)
+}
+
+// AddAdministratorContext is like AddAdministrator but uses ctx for the API
+// request.
+func (c *APIClient) AddAdministratorContext(ctx context.Context, admin NewAdministrator) (string, error) {
+	req, err := c.NewRequestWithContext(ctx, "POST", "admins.json", admin)
+	if err != nil {
+		return "", err
+	}
+
+	var v struct{ EmailAddress string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return "", err
+	}
+	return v.EmailAddress, nil
+}
+
+// UpdateAdministrator updates the administrator with the given email address.
+//
+// See https://www.campaignmonitor.com/api/account/#updating_an_administrator
+// for more information.
+func (c *APIClient) UpdateAdministrator(email string, admin NewAdministrator) error {
+	return c.UpdateAdministratorContext(context.Background(), email, admin)
+}
+
+// UpdateAdministratorContext is like UpdateAdministrator but uses ctx for the
+// API request.
+func (c *APIClient) UpdateAdministratorContext(ctx context.Context, email string, admin NewAdministrator) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := "admins.json?" + url.Values{"email": {email}}.Encode()
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, admin)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// DeleteAdministrator removes the administrator with the given email address
+// from the authenticated account.
+//
+// See https://www.campaignmonitor.com/api/account/#deleting_an_administrator
+// for more information.
+func (c *APIClient) DeleteAdministrator(email string) error {
+	return c.DeleteAdministratorContext(context.Background(), email)
+}
+
+// DeleteAdministratorContext is like DeleteAdministrator but uses ctx for the
+// API request.
+func (c *APIClient) DeleteAdministratorContext(ctx context.Context, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := "admins.json?" + url.Values{"email": {email}}.Encode()
+
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
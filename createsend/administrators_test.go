@@ -0,0 +1,111 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAdministrators(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admins.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[
+			{"EmailAddress": "alice@example.com", "Name": "Alice", "Status": "Active"},
+			{"EmailAddress": "bob@example.com", "Name": "Bob", "Status": "Waiting to Accept the Invitation"}
+		]`)
+	})
+
+	admins, err := client.Administrators()
+	if err != nil {
+		t.Errorf("Administrators returned error: %v", err)
+	}
+
+	want := []*Administrator{
+		{EmailAddress: "alice@example.com", Name: "Alice", Status: PersonActive},
+		{EmailAddress: "bob@example.com", Name: "Bob", Status: PersonInvited},
+	}
+	if !reflect.DeepEqual(admins, want) {
+		t.Errorf("Administrators returned %+v, want %+v", admins, want)
+	}
+}
+
+func TestAdministratorDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admins.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "email=alice%40example.com")
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "alice@example.com", "Name": "Alice", "Status": "Active"}`)
+	})
+
+	admin, err := client.AdministratorDetails("alice@example.com")
+	if err != nil {
+		t.Errorf("AdministratorDetails returned error: %v", err)
+	}
+
+	want := &Administrator{EmailAddress: "alice@example.com", Name: "Alice", Status: PersonActive}
+	if !reflect.DeepEqual(admin, want) {
+		t.Errorf("AdministratorDetails returned %+v, want %+v", admin, want)
+	}
+}
+
+func TestAddAdministrator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admins.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got NewAdministrator
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if want := (NewAdministrator{EmailAddress: "alice@example.com", Name: "Alice"}); got != want {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "alice@example.com"}`)
+	})
+
+	email, err := client.AddAdministrator(NewAdministrator{EmailAddress: "alice@example.com", Name: "Alice"})
+	if err != nil {
+		t.Errorf("AddAdministrator returned error: %v", err)
+	}
+	if email != "alice@example.com" {
+		t.Errorf("AddAdministrator returned %q", email)
+	}
+}
+
+func TestUpdateAdministrator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admins.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testQuerystring(t, r, "email=alice%40example.com")
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "alice@example.net"}`)
+	})
+
+	err := client.UpdateAdministrator("alice@example.com", NewAdministrator{EmailAddress: "alice@example.net", Name: "Alice"})
+	if err != nil {
+		t.Errorf("UpdateAdministrator returned error: %v", err)
+	}
+}
+
+func TestDeleteAdministrator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admins.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuerystring(t, r, "email=alice%40example.com")
+	})
+
+	err := client.DeleteAdministrator("alice@example.com")
+	if err != nil {
+		t.Errorf("DeleteAdministrator returned error: %v", err)
+	}
+}
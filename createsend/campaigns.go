@@ -1,15 +1,17 @@
 package createsend
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 )
 
 // CampaignRecipientsOptions represents the URL parameters that may be used to
-// fetch campaign recipients.
+// fetch a page of campaign recipients, or of a campaign's opens, clicks,
+// bounces, unsubscribes or spam complaints.
 //
 // See https://www.campaignmonitor.com/api/campaigns/#campaign_recipients for
 // more information.
@@ -18,20 +20,35 @@ type CampaignRecipientsOptions struct {
 	PageSize       int
 	OrderField     string
 	OrderDirection string
+
+	// Date, if set, restricts the opens, clicks, bounces, unsubscribes and
+	// spam complaints to those on or after it. CampaignRecipients ignores
+	// it.
+	Date time.Time
+
+	// Prefetch is the number of pages CampaignRecipientsAll fetches ahead of
+	// the caller concurrently. It is not sent to the API.
+	Prefetch int
+}
+
+// ResultsPage describes a page of results returned by the API, alongside
+// the results themselves.
+type ResultsPage struct {
+	ResultsOrderedBy     string `json:"ResultsOrderedBy"`
+	OrderDirection       string `json:"OrderDirection"`
+	PageNumber           int    `json:"PageNumber"`
+	PageSize             int    `json:"PageSize"`
+	RecordsOnThisPage    int    `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int    `json:"TotalNumberOfRecords"`
+	NumberOfPages        int    `json:"NumberOfPages"`
 }
 
 // CampaignRecipients lists all the recipients from a campaign.
 // See https://www.campaignmonitor.com/api/campaigns/#campaign_recipients for
 // more information.
 type CampaignRecipients struct {
-	Results              []*Recipient `json:"Results"`
-	ResultsOrderedBy     string       `json:"ResultsOrderedBy"`
-	OrderDirection       string       `json:"OrderDirection"`
-	PageNumber           int          `json:"PageNumber"`
-	PageSize             int          `json:"PageSize"`
-	RecordsOnThisPage    int          `json:"RecordsOnThisPage"`
-	TotalNumberOfRecords int          `json:"TotalNumberOfRecords"`
-	NumberOfPages        int          `json:"NumberOfPages"`
+	Results []*Recipient `json:"Results"`
+	ResultsPage
 }
 
 type Recipient struct {
@@ -40,31 +57,15 @@ type Recipient struct {
 }
 
 func (c *APIClient) CampaignRecipients(campaignID string, opt *CampaignRecipientsOptions) (*CampaignRecipients, error) {
+	return c.CampaignRecipientsContext(context.Background(), campaignID, opt)
+}
 
-	u := fmt.Sprintf("campaigns/%s/recipients.json", campaignID)
-
-	if opt != nil {
-		v := url.Values{}
-		if opt.Page > 0 {
-			v.Set("page", strconv.Itoa(opt.Page))
-		}
-		if opt.PageSize > 0 {
-			v.Set("pagesize", strconv.Itoa(opt.PageSize))
-		}
-		if opt.OrderField != "" {
-			v.Set("orderfield", opt.OrderField)
-		}
-		if opt.OrderDirection != "" {
-			v.Set("orderdirection", opt.OrderDirection)
-		}
+// CampaignRecipientsContext is like CampaignRecipients but uses ctx for the API request.
+func (c *APIClient) CampaignRecipientsContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignRecipients, error) {
 
-		q := v.Encode()
-		if q != "" {
-			u = fmt.Sprintf("%s?%s", u, q)
-		}
-	}
+	u := campaignPageURL(fmt.Sprintf("campaigns/%s/recipients.json", campaignID), opt, false)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +75,77 @@ func (c *APIClient) CampaignRecipients(campaignID string, opt *CampaignRecipient
 	return &results, err
 }
 
+// RecipientIterator iterates over the recipients of a campaign, fetching pages
+// from the API as needed. Call Next to advance it, and Err once Next returns
+// false. If the iteration is abandoned before Next returns false, Close must
+// be called to release its resources.
+type RecipientIterator struct {
+	p       *pager
+	results []*Recipient
+	cur     *Recipient
+}
+
+// Next advances the iterator to the next recipient, fetching the next page if
+// needed. It returns false when there are no more recipients or an error
+// occurred.
+func (it *RecipientIterator) Next() bool {
+	for len(it.results) == 0 {
+		page, ok := it.p.nextPage()
+		if !ok {
+			it.cur = nil
+			return false
+		}
+		it.results = page.(*CampaignRecipients).Results
+	}
+	it.cur, it.results = it.results[0], it.results[1:]
+	return true
+}
+
+// Recipient returns the current recipient.
+func (it *RecipientIterator) Recipient() *Recipient {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any. Recipients
+// returned before the error remain valid.
+func (it *RecipientIterator) Err() error {
+	return it.p.err
+}
+
+// Close stops the iteration, canceling any page requests still in flight.
+func (it *RecipientIterator) Close() {
+	it.p.close()
+}
+
+// CampaignRecipientsAll returns an iterator over all the recipients of a
+// campaign, starting at opt.Page and walking all subsequent pages. opt may be
+// nil.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_recipients for
+// more information.
+func (c *APIClient) CampaignRecipientsAll(campaignID string, opt *CampaignRecipientsOptions) *RecipientIterator {
+	return c.CampaignRecipientsAllContext(context.Background(), campaignID, opt)
+}
+
+// CampaignRecipientsAllContext is like CampaignRecipientsAll but uses ctx for
+// the API requests.
+func (c *APIClient) CampaignRecipientsAllContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) *RecipientIterator {
+	var o CampaignRecipientsOptions
+	if opt != nil {
+		o = *opt
+	}
+	fetch := func(ctx context.Context, page int) (interface{}, int, error) {
+		o := o
+		o.Page = page
+		results, err := c.CampaignRecipientsContext(ctx, campaignID, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return results, results.NumberOfPages, nil
+	}
+	return &RecipientIterator{p: newPager(ctx, o.Page, o.Prefetch, fetch)}
+}
+
 // Campaign struct to create a campaign
 // See https://www.campaignmonitor.com/api/campaigns/ for
 // more information.
@@ -118,10 +190,15 @@ type TemplateContent struct {
 }
 
 func (c *APIClient) CreateCampaign(clientID string, campaign CreateCampaign) (string, error) {
+	return c.CreateCampaignContext(context.Background(), clientID, campaign)
+}
+
+// CreateCampaignContext is like CreateCampaign but uses ctx for the API request.
+func (c *APIClient) CreateCampaignContext(ctx context.Context, clientID string, campaign CreateCampaign) (string, error) {
 
 	u := fmt.Sprintf("campaigns/%s.json", clientID)
 
-	req, err := c.NewRequest("POST", u, campaign)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, campaign)
 	if err != nil {
 		return "", err
 	}
@@ -129,21 +206,20 @@ func (c *APIClient) CreateCampaign(clientID string, campaign CreateCampaign) (st
 	var results string
 	err = c.Do(req, &results)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return results, nil
-		} else {
-			return results, err
-		}
+		return "", err
 	}
-	return results, err
+	return results, nil
 }
 
 func (c *APIClient) CreateCampaignFromTemplate(clientID string, campaign CreateCampaign) (string, error) {
+	return c.CreateCampaignFromTemplateContext(context.Background(), clientID, campaign)
+}
+
+// CreateCampaignFromTemplateContext is like CreateCampaignFromTemplate but uses ctx for the API request.
+func (c *APIClient) CreateCampaignFromTemplateContext(ctx context.Context, clientID string, campaign CreateCampaign) (string, error) {
 	u := fmt.Sprintf("campaigns/%s/fromTemplate.json", clientID)
 
-	req, err := c.NewRequest("POST", u, campaign)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, campaign)
 	if err != nil {
 		return "", err
 	}
@@ -151,15 +227,9 @@ func (c *APIClient) CreateCampaignFromTemplate(clientID string, campaign CreateC
 	var results string
 	err = c.Do(req, &results)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return results, nil
-		} else {
-			return results, err
-		}
+		return "", err
 	}
-	return results, err
+	return results, nil
 }
 
 type ScheduleCampaign struct {
@@ -168,12 +238,17 @@ type ScheduleCampaign struct {
 }
 
 func (c *APIClient) ScheduleCampaign(campaignID string, confirmationEmail string, sendDate time.Time) (bool, error) {
+	return c.ScheduleCampaignContext(context.Background(), campaignID, confirmationEmail, sendDate)
+}
+
+// ScheduleCampaignContext is like ScheduleCampaign but uses ctx for the API request.
+func (c *APIClient) ScheduleCampaignContext(ctx context.Context, campaignID string, confirmationEmail string, sendDate time.Time) (bool, error) {
 	u := fmt.Sprintf("campaigns/%s/send.json", campaignID)
 
 	sendDateStr := sendDate.Format("2006-01-02 15:04")
 
 	scheduleCampaign := ScheduleCampaign{ConfirmationEmail: confirmationEmail, SendDate: sendDateStr}
-	req, err := c.NewRequest("POST", u, scheduleCampaign)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, scheduleCampaign)
 	if err != nil {
 		return false, err
 	}
@@ -181,21 +256,20 @@ func (c *APIClient) ScheduleCampaign(campaignID string, confirmationEmail string
 	var results string
 	err = c.Do(req, &results)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return true, nil
-		} else {
-			return false, err
-		}
+		return false, err
 	}
 	return true, nil
 }
 
 func (c *APIClient) UnscheduleCampaign(campaignID string) error {
+	return c.UnscheduleCampaignContext(context.Background(), campaignID)
+}
+
+// UnscheduleCampaignContext is like UnscheduleCampaign but uses ctx for the API request.
+func (c *APIClient) UnscheduleCampaignContext(ctx context.Context, campaignID string) error {
 	u := fmt.Sprintf("campaigns/%s/unschedule.json", campaignID)
 
-	req, err := c.NewRequest("POST", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, nil)
 	if err != nil {
 		return err
 	}
@@ -203,21 +277,161 @@ func (c *APIClient) UnscheduleCampaign(campaignID string) error {
 	var results string
 	err = c.Do(req, &results)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 	return nil
 }
 
+// Values for the personalize argument of SendCampaignPreview, besides the
+// email address of a subscriber whose custom field values should be used.
+const (
+	PersonalizeFallback = "Fallback"
+	PersonalizeRandom   = "Random"
+)
+
+type campaignPreview struct {
+	PreviewRecipients []string `json:"PreviewRecipients"`
+	Personalize       string   `json:"Personalize,omitempty"`
+}
+
+// SendCampaignPreview sends a preview of a draft campaign to the given
+// recipients. personalize selects how personalization tags are filled in: one
+// of PersonalizeFallback or PersonalizeRandom, or the email address of a
+// subscriber whose details should be used. If empty, the API uses
+// PersonalizeFallback.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#sending_campaign_preview
+// for more information.
+func (c *APIClient) SendCampaignPreview(campaignID string, recipients []string, personalize string) error {
+	return c.SendCampaignPreviewContext(context.Background(), campaignID, recipients, personalize)
+}
+
+// SendCampaignPreviewContext is like SendCampaignPreview but uses ctx for the
+// API request.
+func (c *APIClient) SendCampaignPreviewContext(ctx context.Context, campaignID string, recipients []string, personalize string) error {
+	u := fmt.Sprintf("campaigns/%s/sendpreview.json", campaignID)
+
+	preview := campaignPreview{PreviewRecipients: recipients, Personalize: personalize}
+	req, err := c.NewRequestWithContext(ctx, "POST", u, preview)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// CampaignCopy describes the draft that CopyCampaign creates.
+type CampaignCopy struct {
+	// Draft reports whether the original campaign is a draft. Otherwise it
+	// must be a sent campaign.
+	Draft bool
+
+	// Name is the name of the new campaign. Campaign names must be unique
+	// within a client. If empty, the original name followed by " (copy)" is
+	// used.
+	Name string
+
+	// ListIDs and SegmentIDs are the lists and segments the new campaign is
+	// to be sent to. If both are empty, those of the original campaign are
+	// used.
+	ListIDs    []string
+	SegmentIDs []string
+
+	// TemplateID and TemplateContent are the template and content the new
+	// campaign is built from. TemplateID is required.
+	TemplateID      string
+	TemplateContent TemplateContent
+}
+
+// CopyCampaign creates a new draft campaign for a client from one of its
+// sent or draft campaigns and returns the ID of the new draft. The name,
+// subject and sender are read from the client's sent campaigns, or its drafts
+// if cp.Draft is set, and the lists and segments with
+// CampaignListsAndSegments unless cp gives others.
+//
+// The API does not return the TemplateID or TemplateContent a campaign was
+// created with, so the content cannot be cloned: the draft is created with
+// CreateCampaignFromTemplate from cp.TemplateID and cp.TemplateContent,
+// typically those the original campaign was created from.
+//
+// If the client has no such campaign, the returned error matches
+// ErrNotFound.
+func (c *APIClient) CopyCampaign(clientID string, campaignID string, cp CampaignCopy) (string, error) {
+	return c.CopyCampaignContext(context.Background(), clientID, campaignID, cp)
+}
+
+// CopyCampaignContext is like CopyCampaign but uses ctx for the API requests.
+func (c *APIClient) CopyCampaignContext(ctx context.Context, clientID string, campaignID string, cp CampaignCopy) (string, error) {
+	if cp.TemplateID == "" {
+		return "", errors.New("createsend: CopyCampaign requires a TemplateID")
+	}
+
+	campaign, err := c.findCampaign(ctx, clientID, campaignID, cp.Draft)
+	if err != nil {
+		return "", err
+	}
+
+	if cp.Name != "" {
+		campaign.Name = cp.Name
+	} else {
+		campaign.Name += " (copy)"
+	}
+	campaign.ListIDs = cp.ListIDs
+	campaign.SegmentIDs = cp.SegmentIDs
+	if len(cp.ListIDs) == 0 && len(cp.SegmentIDs) == 0 {
+		ls, err := c.CampaignListsAndSegmentsContext(ctx, campaignID)
+		if err != nil {
+			return "", err
+		}
+		for _, l := range ls.Lists {
+			campaign.ListIDs = append(campaign.ListIDs, l.ListID)
+		}
+		for _, s := range ls.Segments {
+			campaign.SegmentIDs = append(campaign.SegmentIDs, s.SegmentID)
+		}
+	}
+	campaign.TemplateID = cp.TemplateID
+	campaign.TemplateContent = cp.TemplateContent
+	return c.CreateCampaignFromTemplateContext(ctx, clientID, *campaign)
+}
+
+// findCampaign looks up a campaign among the sent or draft campaigns of a
+// client, and returns its name, subject and sender.
+func (c *APIClient) findCampaign(ctx context.Context, clientID string, campaignID string, draft bool) (*CreateCampaign, error) {
+	if draft {
+		drafts, err := c.DraftCampaignsContext(ctx, clientID)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range drafts {
+			if d.CampaignID == campaignID {
+				return &CreateCampaign{Name: d.Name, Subject: d.Subject, FromName: d.FromName, FromEmail: d.FromEmail, ReplyTo: d.ReplyTo}, nil
+			}
+		}
+		return nil, fmt.Errorf("draft campaign %s not found in client %s: %w", campaignID, clientID, ErrNotFound)
+	}
+
+	sent, err := c.CampaignsContext(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sent {
+		if s.CampaignID == campaignID {
+			return &CreateCampaign{Name: s.Name, Subject: s.Subject, FromName: s.FromName, FromEmail: s.FromEmail, ReplyTo: s.ReplyTo}, nil
+		}
+	}
+	return nil, fmt.Errorf("sent campaign %s not found in client %s: %w", campaignID, clientID, ErrNotFound)
+}
+
 func (c *APIClient) DeleteCampaign(campaignID string) error {
+	return c.DeleteCampaignContext(context.Background(), campaignID)
+}
+
+// DeleteCampaignContext is like DeleteCampaign but uses ctx for the API request.
+func (c *APIClient) DeleteCampaignContext(ctx context.Context, campaignID string) error {
 	u := fmt.Sprintf("campaigns/%s.json", campaignID)
 
-	req, err := c.NewRequest("DELETE", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -225,13 +439,348 @@ func (c *APIClient) DeleteCampaign(campaignID string) error {
 	var results string
 	err = c.Do(req, &results)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 	return nil
 }
+
+// CampaignSummary holds the headline results of a sent campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_summary for more
+// information.
+type CampaignSummary struct {
+	Name              string `json:"Name"`
+	Recipients        int    `json:"Recipients"`
+	TotalOpened       int    `json:"TotalOpened"`
+	Clicks            int    `json:"Clicks"`
+	Unsubscribed      int    `json:"Unsubscribed"`
+	Bounced           int    `json:"Bounced"`
+	UniqueOpened      int    `json:"UniqueOpened"`
+	SpamComplaints    int    `json:"SpamComplaints"`
+	WebVersionURL     string `json:"WebVersionURL"`
+	WebVersionTextURL string `json:"WebVersionTextURL"`
+	WorldviewURL      string `json:"WorldviewURL"`
+	Forwards          int    `json:"Forwards"`
+	Likes             int    `json:"Likes"`
+	Mentions          int    `json:"Mentions"`
+}
+
+// CampaignSummary returns the summary of a sent campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_summary for more
+// information.
+func (c *APIClient) CampaignSummary(campaignID string) (*CampaignSummary, error) {
+	return c.CampaignSummaryContext(context.Background(), campaignID)
+}
+
+// CampaignSummaryContext is like CampaignSummary but uses ctx for the API
+// request.
+func (c *APIClient) CampaignSummaryContext(ctx context.Context, campaignID string) (*CampaignSummary, error) {
+	u := fmt.Sprintf("campaigns/%s/summary.json", campaignID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary CampaignSummary
+	err = c.Do(req, &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// EmailClientUsage is the share of a campaign's opens made with an email
+// client.
+type EmailClientUsage struct {
+	Client      string  `json:"Client"`
+	Version     string  `json:"Version"`
+	Percentage  float64 `json:"Percentage"`
+	Subscribers int     `json:"Subscribers"`
+}
+
+// CampaignEmailClientUsage returns the email clients subscribers opened a
+// campaign with.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_email_client_usage
+// for more information.
+func (c *APIClient) CampaignEmailClientUsage(campaignID string) ([]*EmailClientUsage, error) {
+	return c.CampaignEmailClientUsageContext(context.Background(), campaignID)
+}
+
+// CampaignEmailClientUsageContext is like CampaignEmailClientUsage but uses
+// ctx for the API request.
+func (c *APIClient) CampaignEmailClientUsageContext(ctx context.Context, campaignID string) ([]*EmailClientUsage, error) {
+	u := fmt.Sprintf("campaigns/%s/emailclientusage.json", campaignID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []*EmailClientUsage
+	err = c.Do(req, &usage)
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// CampaignListsAndSegments holds the lists and segments a campaign was sent
+// to.
+type CampaignListsAndSegments struct {
+	Lists    []*CampaignList    `json:"Lists"`
+	Segments []*CampaignSegment `json:"Segments"`
+}
+
+type CampaignList struct {
+	ListID string `json:"ListID"`
+	Name   string `json:"Name"`
+}
+
+type CampaignSegment struct {
+	ListID    string `json:"ListID"`
+	SegmentID string `json:"SegmentID"`
+	Title     string `json:"Title"`
+}
+
+// CampaignListsAndSegments returns the lists and segments a campaign was sent
+// to.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_listsandsegments
+// for more information.
+func (c *APIClient) CampaignListsAndSegments(campaignID string) (*CampaignListsAndSegments, error) {
+	return c.CampaignListsAndSegmentsContext(context.Background(), campaignID)
+}
+
+// CampaignListsAndSegmentsContext is like CampaignListsAndSegments but uses
+// ctx for the API request.
+func (c *APIClient) CampaignListsAndSegmentsContext(ctx context.Context, campaignID string) (*CampaignListsAndSegments, error) {
+	u := fmt.Sprintf("campaigns/%s/listsandsegments.json", campaignID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ls CampaignListsAndSegments
+	err = c.Do(req, &ls)
+	if err != nil {
+		return nil, err
+	}
+	return &ls, nil
+}
+
+// Location is the approximate location of the subscriber who opened or
+// clicked a campaign.
+type Location struct {
+	IPAddress   string  `json:"IPAddress"`
+	Latitude    float64 `json:"Latitude"`
+	Longitude   float64 `json:"Longitude"`
+	City        string  `json:"City"`
+	Region      string  `json:"Region"`
+	CountryCode string  `json:"CountryCode"`
+	CountryName string  `json:"CountryName"`
+}
+
+type CampaignOpen struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	Date         string `json:"Date"`
+	Location
+}
+
+type CampaignOpens struct {
+	Results []*CampaignOpen `json:"Results"`
+	ResultsPage
+}
+
+type CampaignClick struct {
+	EmailAddress string `json:"EmailAddress"`
+	URL          string `json:"URL"`
+	ListID       string `json:"ListID"`
+	Date         string `json:"Date"`
+	Location
+}
+
+type CampaignClicks struct {
+	Results []*CampaignClick `json:"Results"`
+	ResultsPage
+}
+
+type CampaignBounce struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	BounceType   string `json:"BounceType"`
+	Date         string `json:"Date"`
+	Reason       string `json:"Reason"`
+}
+
+type CampaignBounces struct {
+	Results []*CampaignBounce `json:"Results"`
+	ResultsPage
+}
+
+type CampaignUnsubscribe struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	Date         string `json:"Date"`
+	IPAddress    string `json:"IPAddress"`
+}
+
+type CampaignUnsubscribes struct {
+	Results []*CampaignUnsubscribe `json:"Results"`
+	ResultsPage
+}
+
+type CampaignSpamComplaint struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	Date         string `json:"Date"`
+}
+
+type CampaignSpamComplaints struct {
+	Results []*CampaignSpamComplaint `json:"Results"`
+	ResultsPage
+}
+
+// CampaignOpens returns a page of the opens of a campaign. opt may be nil.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_opens for more
+// information.
+func (c *APIClient) CampaignOpens(campaignID string, opt *CampaignRecipientsOptions) (*CampaignOpens, error) {
+	return c.CampaignOpensContext(context.Background(), campaignID, opt)
+}
+
+// CampaignOpensContext is like CampaignOpens but uses ctx for the API request.
+func (c *APIClient) CampaignOpensContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignOpens, error) {
+	var results CampaignOpens
+	err := c.campaignActivity(ctx, campaignID, "opens", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignClicks returns a page of the link clicks of a campaign. opt may be
+// nil.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_clicks for more
+// information.
+func (c *APIClient) CampaignClicks(campaignID string, opt *CampaignRecipientsOptions) (*CampaignClicks, error) {
+	return c.CampaignClicksContext(context.Background(), campaignID, opt)
+}
+
+// CampaignClicksContext is like CampaignClicks but uses ctx for the API
+// request.
+func (c *APIClient) CampaignClicksContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignClicks, error) {
+	var results CampaignClicks
+	err := c.campaignActivity(ctx, campaignID, "clicks", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignBounces returns a page of the bounces of a campaign. opt may be nil.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_bounces for more
+// information.
+func (c *APIClient) CampaignBounces(campaignID string, opt *CampaignRecipientsOptions) (*CampaignBounces, error) {
+	return c.CampaignBouncesContext(context.Background(), campaignID, opt)
+}
+
+// CampaignBouncesContext is like CampaignBounces but uses ctx for the API
+// request.
+func (c *APIClient) CampaignBouncesContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignBounces, error) {
+	var results CampaignBounces
+	err := c.campaignActivity(ctx, campaignID, "bounces", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignUnsubscribes returns a page of the subscribers who unsubscribed
+// through a campaign. opt may be nil.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_unsubscribes for
+// more information.
+func (c *APIClient) CampaignUnsubscribes(campaignID string, opt *CampaignRecipientsOptions) (*CampaignUnsubscribes, error) {
+	return c.CampaignUnsubscribesContext(context.Background(), campaignID, opt)
+}
+
+// CampaignUnsubscribesContext is like CampaignUnsubscribes but uses ctx for
+// the API request.
+func (c *APIClient) CampaignUnsubscribesContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignUnsubscribes, error) {
+	var results CampaignUnsubscribes
+	err := c.campaignActivity(ctx, campaignID, "unsubscribes", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignSpamComplaints returns a page of the subscribers who marked a
+// campaign as spam. opt may be nil.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_spam_complaints
+// for more information.
+func (c *APIClient) CampaignSpamComplaints(campaignID string, opt *CampaignRecipientsOptions) (*CampaignSpamComplaints, error) {
+	return c.CampaignSpamComplaintsContext(context.Background(), campaignID, opt)
+}
+
+// CampaignSpamComplaintsContext is like CampaignSpamComplaints but uses ctx
+// for the API request.
+func (c *APIClient) CampaignSpamComplaintsContext(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignSpamComplaints, error) {
+	var results CampaignSpamComplaints
+	err := c.campaignActivity(ctx, campaignID, "spam", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// campaignActivity fetches a page of the given kind of campaign activity into
+// results.
+func (c *APIClient) campaignActivity(ctx context.Context, campaignID, kind string, opt *CampaignRecipientsOptions, results interface{}) error {
+	u := campaignPageURL(fmt.Sprintf("campaigns/%s/%s.json", campaignID, kind), opt, true)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, results)
+}
+
+// campaignPageURL returns u with the URL parameters of opt, which may be nil.
+// opt.Date is only included if date is set.
+func campaignPageURL(u string, opt *CampaignRecipientsOptions, date bool) string {
+	if opt == nil {
+		return u
+	}
+
+	v := url.Values{}
+	if date && !opt.Date.IsZero() {
+		v.Set("date", opt.Date.Format("2006-01-02 15:04"))
+	}
+	if opt.Page > 0 {
+		v.Set("page", strconv.Itoa(opt.Page))
+	}
+	if opt.PageSize > 0 {
+		v.Set("pagesize", strconv.Itoa(opt.PageSize))
+	}
+	if opt.OrderField != "" {
+		v.Set("orderfield", opt.OrderField)
+	}
+	if opt.OrderDirection != "" {
+		v.Set("orderdirection", opt.OrderDirection)
+	}
+
+	if q := v.Encode(); q != "" {
+		u = fmt.Sprintf("%s?%s", u, q)
+	}
+	return u
+}
@@ -1,10 +1,13 @@
 package createsend
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestCampaignRecipients(t *testing.T) {
@@ -66,14 +69,16 @@ func TestCampaignRecipients(t *testing.T) {
 		},
 	}
 	want := &CampaignRecipients{
-		Results:              recs,
-		ResultsOrderedBy:     "email",
-		OrderDirection:       "asc",
-		PageNumber:           1,
-		PageSize:             1000,
-		RecordsOnThisPage:    4,
-		TotalNumberOfRecords: 4,
-		NumberOfPages:        1,
+		Results: recs,
+		ResultsPage: ResultsPage{
+			ResultsOrderedBy:     "email",
+			OrderDirection:       "asc",
+			PageNumber:           1,
+			PageSize:             1000,
+			RecordsOnThisPage:    4,
+			TotalNumberOfRecords: 4,
+			NumberOfPages:        1,
+		},
 	}
 
 	if !reflect.DeepEqual(campaigns, want) {
@@ -158,17 +163,478 @@ func TestCampaignRecipientsOptions(t *testing.T) {
 		},
 	}
 	want := &CampaignRecipients{
-		Results:              recs,
-		ResultsOrderedBy:     "email",
-		OrderDirection:       "desc",
-		PageNumber:           2,
-		PageSize:             300,
-		RecordsOnThisPage:    4,
-		TotalNumberOfRecords: 304,
-		NumberOfPages:        2,
+		Results: recs,
+		ResultsPage: ResultsPage{
+			ResultsOrderedBy:     "email",
+			OrderDirection:       "desc",
+			PageNumber:           2,
+			PageSize:             300,
+			RecordsOnThisPage:    4,
+			TotalNumberOfRecords: 304,
+			NumberOfPages:        2,
+		},
 	}
 
 	if !reflect.DeepEqual(campaigns, want) {
 		t.Errorf("CampaignRecipients returend %+v, want %+v", campaigns, want)
 	}
 }
+
+func TestCampaignRecipientsAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/recipients.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "2":
+			_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "alice@example.com", "ListID": "12CD"}], "PageNumber": 2, "NumberOfPages": 3}`)
+		case "3":
+			_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "bob@example.com", "ListID": "12CD"}], "PageNumber": 3, "NumberOfPages": 3}`)
+		default:
+			t.Errorf("Unexpected page %s", r.FormValue("page"))
+		}
+	})
+
+	it := client.CampaignRecipientsAll("13CD", &CampaignRecipientsOptions{Page: 2})
+	defer it.Close()
+
+	var got []*Recipient
+	for it.Next() {
+		got = append(got, it.Recipient())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("CampaignRecipientsAll returned error: %v", err)
+	}
+
+	want := []*Recipient{{EmailAddress: "alice@example.com", ListID: "12CD"}, {EmailAddress: "bob@example.com", ListID: "12CD"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CampaignRecipientsAll returned %+v, want %+v", got, want)
+	}
+}
+
+func TestCampaignSummary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"Name": "Campaign One",
+			"Recipients": 5,
+			"TotalOpened": 10,
+			"Clicks": 2,
+			"Unsubscribed": 1,
+			"Bounced": 1,
+			"UniqueOpened": 4,
+			"SpamComplaints": 0,
+			"WebVersionURL": "http://createsend.com/t/r-3A433FC72FFE3B8B",
+			"WebVersionTextURL": "http://createsend.com/t/r-3A433FC72FFE3B8B/t",
+			"WorldviewURL": "http://client.createsend.com/reports/wv/r/3A433FC72FFE3B8B",
+			"Forwards": 11,
+			"Likes": 32,
+			"Mentions": 25
+		}`)
+	})
+
+	summary, err := client.CampaignSummary("12ab")
+	if err != nil {
+		t.Errorf("CampaignSummary returned error: %v", err)
+	}
+
+	want := &CampaignSummary{
+		Name:              "Campaign One",
+		Recipients:        5,
+		TotalOpened:       10,
+		Clicks:            2,
+		Unsubscribed:      1,
+		Bounced:           1,
+		UniqueOpened:      4,
+		WebVersionURL:     "http://createsend.com/t/r-3A433FC72FFE3B8B",
+		WebVersionTextURL: "http://createsend.com/t/r-3A433FC72FFE3B8B/t",
+		WorldviewURL:      "http://client.createsend.com/reports/wv/r/3A433FC72FFE3B8B",
+		Forwards:          11,
+		Likes:             32,
+		Mentions:          25,
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("CampaignSummary returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestCampaignEmailClientUsage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/emailclientusage.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[{"Client": "iOS Devices", "Version": "iPhone", "Percentage": 19.83, "Subscribers": 7056}]`)
+	})
+
+	usage, err := client.CampaignEmailClientUsage("12ab")
+	if err != nil {
+		t.Errorf("CampaignEmailClientUsage returned error: %v", err)
+	}
+
+	want := []*EmailClientUsage{{Client: "iOS Devices", Version: "iPhone", Percentage: 19.83, Subscribers: 7056}}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("CampaignEmailClientUsage returned %+v, want %+v", usage, want)
+	}
+}
+
+func TestCampaignListsAndSegments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/listsandsegments.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"Lists": [{"ListID": "a58ee1d3", "Name": "List One"}],
+			"Segments": [{"ListID": "2bea949d", "SegmentID": "dba84a22", "Title": "Segment for campaign"}]
+		}`)
+	})
+
+	ls, err := client.CampaignListsAndSegments("12ab")
+	if err != nil {
+		t.Errorf("CampaignListsAndSegments returned error: %v", err)
+	}
+
+	want := &CampaignListsAndSegments{
+		Lists:    []*CampaignList{{ListID: "a58ee1d3", Name: "List One"}},
+		Segments: []*CampaignSegment{{ListID: "2bea949d", SegmentID: "dba84a22", Title: "Segment for campaign"}},
+	}
+	if !reflect.DeepEqual(ls, want) {
+		t.Errorf("CampaignListsAndSegments returned %+v, want %+v", ls, want)
+	}
+}
+
+func TestCampaignOpens(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/opens.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := "date=2016-01-02+03%3A04&orderdirection=desc&orderfield=date&page=2&pagesize=50"
+		if got := r.URL.RawQuery; got != want {
+			t.Errorf("Query = %q, want %q", got, want)
+		}
+		_, _ = fmt.Fprint(w, `{
+			"Results": [{
+				"EmailAddress": "alice@example.com",
+				"ListID": "512a3bc5",
+				"Date": "2016-01-03 10:00:00",
+				"IPAddress": "192.0.2.1",
+				"Latitude": -33.8683,
+				"Longitude": 151.2086,
+				"City": "Sydney",
+				"Region": "New South Wales",
+				"CountryCode": "AU",
+				"CountryName": "Australia"
+			}],
+			"ResultsOrderedBy": "date",
+			"OrderDirection": "desc",
+			"PageNumber": 2,
+			"PageSize": 50,
+			"RecordsOnThisPage": 1,
+			"TotalNumberOfRecords": 51,
+			"NumberOfPages": 2
+		}`)
+	})
+
+	opens, err := client.CampaignOpens("12ab", &CampaignRecipientsOptions{
+		Date:           time.Date(2016, 1, 2, 3, 4, 0, 0, time.UTC),
+		Page:           2,
+		PageSize:       50,
+		OrderField:     "date",
+		OrderDirection: "desc",
+	})
+	if err != nil {
+		t.Errorf("CampaignOpens returned error: %v", err)
+	}
+
+	want := &CampaignOpens{
+		Results: []*CampaignOpen{{
+			EmailAddress: "alice@example.com",
+			ListID:       "512a3bc5",
+			Date:         "2016-01-03 10:00:00",
+			Location: Location{
+				IPAddress:   "192.0.2.1",
+				Latitude:    -33.8683,
+				Longitude:   151.2086,
+				City:        "Sydney",
+				Region:      "New South Wales",
+				CountryCode: "AU",
+				CountryName: "Australia",
+			},
+		}},
+		ResultsPage: ResultsPage{
+			ResultsOrderedBy:     "date",
+			OrderDirection:       "desc",
+			PageNumber:           2,
+			PageSize:             50,
+			RecordsOnThisPage:    1,
+			TotalNumberOfRecords: 51,
+			NumberOfPages:        2,
+		},
+	}
+	if !reflect.DeepEqual(opens, want) {
+		t.Errorf("CampaignOpens returned %+v, want %+v", opens, want)
+	}
+}
+
+func TestCampaignClicks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/clicks.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "" {
+			t.Errorf("Query = %q, want none", got)
+		}
+		_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "alice@example.com", "URL": "http://example.com/", "ListID": "512a3bc5", "Date": "2016-01-03 10:00:00", "City": "Sydney"}], "NumberOfPages": 1}`)
+	})
+
+	clicks, err := client.CampaignClicks("12ab", nil)
+	if err != nil {
+		t.Errorf("CampaignClicks returned error: %v", err)
+	}
+
+	want := &CampaignClicks{
+		Results:     []*CampaignClick{{EmailAddress: "alice@example.com", URL: "http://example.com/", ListID: "512a3bc5", Date: "2016-01-03 10:00:00", Location: Location{City: "Sydney"}}},
+		ResultsPage: ResultsPage{NumberOfPages: 1},
+	}
+	if !reflect.DeepEqual(clicks, want) {
+		t.Errorf("CampaignClicks returned %+v, want %+v", clicks, want)
+	}
+}
+
+func TestCampaignBounces(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/bounces.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "bob@example.com", "ListID": "512a3bc5", "BounceType": "Soft", "Date": "2016-01-03 10:00:00", "Reason": "Soft Bounce - Mailbox Full"}], "NumberOfPages": 1}`)
+	})
+
+	bounces, err := client.CampaignBounces("12ab", &CampaignRecipientsOptions{Page: 1})
+	if err != nil {
+		t.Errorf("CampaignBounces returned error: %v", err)
+	}
+
+	want := &CampaignBounces{
+		Results:     []*CampaignBounce{{EmailAddress: "bob@example.com", ListID: "512a3bc5", BounceType: "Soft", Date: "2016-01-03 10:00:00", Reason: "Soft Bounce - Mailbox Full"}},
+		ResultsPage: ResultsPage{NumberOfPages: 1},
+	}
+	if !reflect.DeepEqual(bounces, want) {
+		t.Errorf("CampaignBounces returned %+v, want %+v", bounces, want)
+	}
+}
+
+func TestCampaignUnsubscribes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/unsubscribes.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "carol@example.com", "ListID": "512a3bc5", "Date": "2016-01-03 10:00:00", "IPAddress": "192.0.2.3"}], "NumberOfPages": 1}`)
+	})
+
+	unsubs, err := client.CampaignUnsubscribes("12ab", nil)
+	if err != nil {
+		t.Errorf("CampaignUnsubscribes returned error: %v", err)
+	}
+
+	want := &CampaignUnsubscribes{
+		Results:     []*CampaignUnsubscribe{{EmailAddress: "carol@example.com", ListID: "512a3bc5", Date: "2016-01-03 10:00:00", IPAddress: "192.0.2.3"}},
+		ResultsPage: ResultsPage{NumberOfPages: 1},
+	}
+	if !reflect.DeepEqual(unsubs, want) {
+		t.Errorf("CampaignUnsubscribes returned %+v, want %+v", unsubs, want)
+	}
+}
+
+func TestCampaignSpamComplaints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/spam.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "dave@example.com", "ListID": "512a3bc5", "Date": "2016-01-03 10:00:00"}], "NumberOfPages": 1}`)
+	})
+
+	spam, err := client.CampaignSpamComplaints("12ab", nil)
+	if err != nil {
+		t.Errorf("CampaignSpamComplaints returned error: %v", err)
+	}
+
+	want := &CampaignSpamComplaints{
+		Results:     []*CampaignSpamComplaint{{EmailAddress: "dave@example.com", ListID: "512a3bc5", Date: "2016-01-03 10:00:00"}},
+		ResultsPage: ResultsPage{NumberOfPages: 1},
+	}
+	if !reflect.DeepEqual(spam, want) {
+		t.Errorf("CampaignSpamComplaints returned %+v, want %+v", spam, want)
+	}
+}
+
+func TestSendCampaignPreview(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/sendpreview.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		want := map[string]interface{}{
+			"PreviewRecipients": []interface{}{"qa@example.com", "marketing@example.com"},
+			"Personalize":       "Random",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+	})
+
+	err := client.SendCampaignPreview("12ab", []string{"qa@example.com", "marketing@example.com"}, PersonalizeRandom)
+	if err != nil {
+		t.Errorf("SendCampaignPreview returned error: %v", err)
+	}
+}
+
+// handleClientCampaigns registers handlers listing one sent campaign "sent1"
+// and one draft campaign "draft1" for client "c1".
+// handleClientCampaigns serves the sent campaigns of client c1, or its drafts
+// if draft is set, and fails the test if the other list is requested.
+func handleClientCampaigns(t *testing.T, draft bool) {
+	mux.HandleFunc("/clients/c1/campaigns.json", func(w http.ResponseWriter, r *http.Request) {
+		if draft {
+			t.Error("Sent campaigns requested for a draft")
+		}
+		_, _ = fmt.Fprint(w, `[{
+			"CampaignID": "sent1",
+			"Name": "Newsletter",
+			"Subject": "Our news",
+			"FromName": "Shop",
+			"FromEmail": "news@example.com",
+			"ReplyTo": "reply@example.com",
+			"WebVersionURL": "http://createsend.com/t/r-1",
+			"WebVersionTextURL": "http://createsend.com/t/r-1/t"
+		}]`)
+	})
+	mux.HandleFunc("/clients/c1/drafts.json", func(w http.ResponseWriter, r *http.Request) {
+		if !draft {
+			t.Error("Drafts requested for a sent campaign")
+		}
+		_, _ = fmt.Fprint(w, `[{
+			"CampaignID": "draft1",
+			"Name": "Sale",
+			"Subject": "Big sale",
+			"FromName": "Shop",
+			"FromEmail": "sales@example.com",
+			"ReplyTo": "sales@example.com",
+			"PreviewURL": "http://createsend.com/t/d-1",
+			"PreviewTextURL": "http://createsend.com/t/d-1/t"
+		}]`)
+	})
+}
+
+func TestCopyCampaign(t *testing.T) {
+	setup()
+	defer teardown()
+	handleClientCampaigns(t, true)
+
+	content := TemplateContent{Singlelines: []Singleline{{Content: "Hello"}}}
+	mux.HandleFunc("/campaigns/c1/fromTemplate.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got CreateCampaign
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		want := CreateCampaign{
+			Name:            "Sale (copy)",
+			Subject:         "Big sale",
+			FromName:        "Shop",
+			FromEmail:       "sales@example.com",
+			ReplyTo:         "sales@example.com",
+			ListIDs:         []string{"l2"},
+			SegmentIDs:      []string{"s2"},
+			TemplateID:      "t1",
+			TemplateContent: content,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `"new1"`)
+	})
+
+	id, err := client.CopyCampaign("c1", "draft1", CampaignCopy{Draft: true, ListIDs: []string{"l2"}, SegmentIDs: []string{"s2"}, TemplateID: "t1", TemplateContent: content})
+	if err != nil {
+		t.Errorf("CopyCampaign returned error: %v", err)
+	}
+	if id != "new1" {
+		t.Errorf("CopyCampaign returned %q, want %q", id, "new1")
+	}
+}
+
+func TestCopyCampaign_noTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.CopyCampaign("c1", "draft1", CampaignCopy{Name: "Copy"})
+	if err == nil {
+		t.Error("CopyCampaign returned no error without a TemplateID")
+	}
+}
+
+func TestCopyCampaign_fromTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+	handleClientCampaigns(t, false)
+	mux.HandleFunc("/campaigns/sent1/listsandsegments.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"Lists": [{"ListID": "l1", "Name": "Customers"}],
+			"Segments": [{"ListID": "l3", "SegmentID": "s3", "Title": "Regulars"}]
+		}`)
+	})
+
+	content := TemplateContent{Singlelines: []Singleline{{Content: "Hello"}}}
+	mux.HandleFunc("/campaigns/c1/fromTemplate.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got CreateCampaign
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		want := CreateCampaign{
+			Name:            "Newsletter (resend)",
+			Subject:         "Our news",
+			FromName:        "Shop",
+			FromEmail:       "news@example.com",
+			ReplyTo:         "reply@example.com",
+			ListIDs:         []string{"l1"},
+			SegmentIDs:      []string{"s3"},
+			TemplateID:      "t1",
+			TemplateContent: content,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `"new2"`)
+	})
+
+	id, err := client.CopyCampaign("c1", "sent1", CampaignCopy{Name: "Newsletter (resend)", TemplateID: "t1", TemplateContent: content})
+	if err != nil {
+		t.Errorf("CopyCampaign returned error: %v", err)
+	}
+	if id != "new2" {
+		t.Errorf("CopyCampaign returned %q, want %q", id, "new2")
+	}
+}
+
+func TestCopyCampaign_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+	handleClientCampaigns(t, false)
+
+	_, err := client.CopyCampaign("c1", "draft1", CampaignCopy{Name: "Copy", TemplateID: "t1"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("CopyCampaign returned error %v, want ErrNotFound", err)
+	}
+}
@@ -1,8 +1,11 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
-	"strings"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 // A Client represents a client of a Campaign Monitor account.
@@ -19,9 +22,14 @@ type Client struct {
 // See http://www.campaignmonitor.com/api/account/#getting_your_clients for more
 // information.
 func (c *APIClient) ListClients() ([]Client, error) {
+	return c.ListClientsContext(context.Background())
+}
+
+// ListClientsContext is like ListClients but uses ctx for the API request.
+func (c *APIClient) ListClientsContext(ctx context.Context) ([]Client, error) {
 	u := "clients.json"
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -29,17 +37,10 @@ func (c *APIClient) ListClients() ([]Client, error) {
 	clients := new([]Client)
 	err = c.Do(req, clients)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return *clients, nil
-		} else {
-			return nil, err
-		}
 		return nil, err
 	}
 
-	return *clients, err
+	return *clients, nil
 }
 
 // ListLists returns all of the subscriber lists that belong to a client.
@@ -47,9 +48,14 @@ func (c *APIClient) ListClients() ([]Client, error) {
 // See http://www.campaignmonitor.com/api/clients/#subscriber_lists for more
 // information.
 func (c *APIClient) ListLists(clientID string) ([]*List, error) {
+	return c.ListListsContext(context.Background(), clientID)
+}
+
+// ListListsContext is like ListLists but uses ctx for the API request.
+func (c *APIClient) ListListsContext(ctx context.Context, clientID string) ([]*List, error) {
 	u := fmt.Sprintf("clients/%s/lists.json", clientID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,15 +63,9 @@ func (c *APIClient) ListLists(clientID string) ([]*List, error) {
 	var lists []*List
 	err = c.Do(req, &lists)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return lists, nil
-		} else {
-			return lists, err
-		}
+		return nil, err
 	}
-	return lists, err
+	return lists, nil
 }
 
 // ListForEmail represents a subscriber list *and* a specific email address's
@@ -94,9 +94,18 @@ func (e *ListForEmail) IsUnsubscribed() bool {
 // See http://www.campaignmonitor.com/api/clients/#lists_for_email for more
 // information.
 func (c *APIClient) ListsForEmail(clientID string, email string) ([]*ListForEmail, error) {
-	u := fmt.Sprintf("clients/%s/listsforemail.json?email=%s", clientID, email)
+	return c.ListsForEmailContext(context.Background(), clientID, email)
+}
 
-	req, err := c.NewRequest("GET", u, nil)
+// ListsForEmailContext is like ListsForEmail but uses ctx for the API request.
+func (c *APIClient) ListsForEmailContext(ctx context.Context, clientID string, email string) ([]*ListForEmail, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("clients/%s/listsforemail.json?%s", clientID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -104,16 +113,10 @@ func (c *APIClient) ListsForEmail(clientID string, email string) ([]*ListForEmai
 	var lists []*ListForEmail
 	err = c.Do(req, &lists)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return lists, nil
-		} else {
-			return lists, err
-		}
+		return nil, err
 	}
 
-	return lists, err
+	return lists, nil
 }
 
 type Campaign struct {
@@ -167,9 +170,14 @@ type Template struct {
 // See https://www.campaignmonitor.com/api/clients/#sent_campaigns for more
 // information.
 func (c *APIClient) Campaigns(clientID string) ([]*Campaign, error) {
+	return c.CampaignsContext(context.Background(), clientID)
+}
+
+// CampaignsContext is like Campaigns but uses ctx for the API request.
+func (c *APIClient) CampaignsContext(ctx context.Context, clientID string) ([]*Campaign, error) {
 	u := fmt.Sprintf("clients/%s/campaigns.json", clientID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -177,15 +185,9 @@ func (c *APIClient) Campaigns(clientID string) ([]*Campaign, error) {
 	var campaigns []*Campaign
 	err = c.Do(req, &campaigns)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return campaigns, nil
-		} else {
-			return campaigns, err
-		}
+		return nil, err
 	}
-	return campaigns, err
+	return campaigns, nil
 }
 
 // ScheduledCampaigns return all the scheduled campaigns for a specific client
@@ -193,9 +195,14 @@ func (c *APIClient) Campaigns(clientID string) ([]*Campaign, error) {
 // See https://www.campaignmonitor.com/api/clients/#sent_campaigns for more
 // information.
 func (c *APIClient) ScheduledCampaigns(clientID string) ([]*ScheduledCampaign, error) {
+	return c.ScheduledCampaignsContext(context.Background(), clientID)
+}
+
+// ScheduledCampaignsContext is like ScheduledCampaigns but uses ctx for the API request.
+func (c *APIClient) ScheduledCampaignsContext(ctx context.Context, clientID string) ([]*ScheduledCampaign, error) {
 	u := fmt.Sprintf("clients/%s/scheduled.json", clientID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -203,15 +210,9 @@ func (c *APIClient) ScheduledCampaigns(clientID string) ([]*ScheduledCampaign, e
 	var campaigns []*ScheduledCampaign
 	err = c.Do(req, &campaigns)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return campaigns, nil
-		} else {
-			return campaigns, err
-		}
+		return nil, err
 	}
-	return campaigns, err
+	return campaigns, nil
 }
 
 // DraftCampaigns return all the draft campaigns for a specific client
@@ -219,9 +220,14 @@ func (c *APIClient) ScheduledCampaigns(clientID string) ([]*ScheduledCampaign, e
 // See https://www.campaignmonitor.com/api/clients/#sent_campaigns for more
 // information.
 func (c *APIClient) DraftCampaigns(clientID string) ([]*DraftCampaign, error) {
+	return c.DraftCampaignsContext(context.Background(), clientID)
+}
+
+// DraftCampaignsContext is like DraftCampaigns but uses ctx for the API request.
+func (c *APIClient) DraftCampaignsContext(ctx context.Context, clientID string) ([]*DraftCampaign, error) {
 	u := fmt.Sprintf("clients/%s/drafts.json", clientID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -229,22 +235,21 @@ func (c *APIClient) DraftCampaigns(clientID string) ([]*DraftCampaign, error) {
 	var campaigns []*DraftCampaign
 	err = c.Do(req, &campaigns)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return campaigns, nil
-		} else {
-			return campaigns, err
-		}
+		return nil, err
 	}
-	return campaigns, err
+	return campaigns, nil
 }
 
 // Campaigns return all the templates for a specific client
 func (c *APIClient) ListTemplates(clientID string) ([]*Template, error) {
+	return c.ListTemplatesContext(context.Background(), clientID)
+}
+
+// ListTemplatesContext is like ListTemplates but uses ctx for the API request.
+func (c *APIClient) ListTemplatesContext(ctx context.Context, clientID string) ([]*Template, error) {
 	u := fmt.Sprintf("clients/%s/templates.json", clientID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -252,14 +257,513 @@ func (c *APIClient) ListTemplates(clientID string) ([]*Template, error) {
 	var templates []*Template
 	err = c.Do(req, &templates)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return templates, nil
-		} else {
-			return templates, err
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// ClientBasics represents the basic details of a client, as used by
+// ClientCreate and ClientUpdateBasics.
+//
+// See https://www.campaignmonitor.com/api/clients/#creating_a_client for more
+// information.
+type ClientBasics struct {
+	CompanyName string `json:"CompanyName"`
+	Country     string `json:"Country"`
+	TimeZone    string `json:"TimeZone"`
+}
+
+// ClientBasicDetails represents the basic details returned by ClientDetails.
+type ClientBasicDetails struct {
+	ClientID     string `json:"ClientID"`
+	CompanyName  string `json:"CompanyName"`
+	ContactName  string `json:"ContactName"`
+	EmailAddress string `json:"EmailAddress"`
+	Country      string `json:"Country"`
+	TimeZone     string `json:"TimeZone"`
+}
+
+// ClientBillingDetails represents the billing settings returned by
+// ClientDetails.
+type ClientBillingDetails struct {
+	CanPurchaseCredits     bool          `json:"CanPurchaseCredits"`
+	Credits                int           `json:"Credits"`
+	ClientPays             bool          `json:"ClientPays"`
+	Currency               string        `json:"Currency"`
+	MonthlyScheme          MonthlyScheme `json:"MonthlyScheme"`
+	BaseRatePerRecipient   float64       `json:"BaseRatePerRecipient"`
+	MarkupPerRecipient     float64       `json:"MarkupPerRecipient"`
+	BaseDeliveryRate       float64       `json:"BaseDeliveryRate"`
+	MarkupOnDelivery       float64       `json:"MarkupOnDelivery"`
+	BaseDesignSpamTestRate float64       `json:"BaseDesignSpamTestRate"`
+	MarkupOnDesignSpamTest float64       `json:"MarkupOnDesignSpamTest"`
+}
+
+// ClientDetails represents the full details of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_a_client for more
+// information.
+type ClientDetails struct {
+	ApiKey         string               `json:"ApiKey"`
+	BasicDetails   ClientBasicDetails   `json:"BasicDetails"`
+	BillingDetails ClientBillingDetails `json:"BillingDetails"`
+}
+
+// ClientCreate creates a new client and returns its ID.
+//
+// See https://www.campaignmonitor.com/api/clients/#creating_a_client for more
+// information.
+func (c *APIClient) ClientCreate(basics ClientBasics) (string, error) {
+	return c.ClientCreateContext(context.Background(), basics)
+}
+
+// ClientCreateContext is like ClientCreate but uses ctx for the API request.
+func (c *APIClient) ClientCreateContext(ctx context.Context, basics ClientBasics) (string, error) {
+	req, err := c.NewRequestWithContext(ctx, "POST", "clients.json", basics)
+	if err != nil {
+		return "", err
+	}
+
+	var clientID string
+	err = c.Do(req, &clientID)
+	if err != nil {
+		return "", err
+	}
+	return clientID, nil
+}
+
+// ClientDetails returns the full details of a client, including its billing
+// settings.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_a_client for more
+// information.
+func (c *APIClient) ClientDetails(clientID string) (*ClientDetails, error) {
+	return c.ClientDetailsContext(context.Background(), clientID)
+}
+
+// ClientDetailsContext is like ClientDetails but uses ctx for the API request.
+func (c *APIClient) ClientDetailsContext(ctx context.Context, clientID string) (*ClientDetails, error) {
+	u := fmt.Sprintf("clients/%s.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details ClientDetails
+	err = c.Do(req, &details)
+	if err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
+
+// ClientUpdateBasics updates the basic details of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_basic_details for
+// more information.
+func (c *APIClient) ClientUpdateBasics(clientID string, basics ClientBasics) error {
+	return c.ClientUpdateBasicsContext(context.Background(), clientID, basics)
+}
+
+// ClientUpdateBasicsContext is like ClientUpdateBasics but uses ctx for the API
+// request.
+func (c *APIClient) ClientUpdateBasicsContext(ctx context.Context, clientID string, basics ClientBasics) error {
+	u := fmt.Sprintf("clients/%s/setbasics.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, basics)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// PAYGBilling represents the pay-as-you-go billing settings of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_payg_billing for
+// more information.
+type PAYGBilling struct {
+	Currency               string  `json:"Currency"`
+	CanPurchaseCredits     bool    `json:"CanPurchaseCredits"`
+	ClientPays             bool    `json:"ClientPays"`
+	MarkupPercentage       int     `json:"MarkupPercentage"`
+	MarkupOnDelivery       float64 `json:"MarkupOnDelivery,omitempty"`
+	MarkupPerRecipient     float64 `json:"MarkupPerRecipient,omitempty"`
+	MarkupOnDesignSpamTest float64 `json:"MarkupOnDesignSpamTest,omitempty"`
+}
+
+// ClientSetPAYGBilling switches a client to pay-as-you-go billing.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_payg_billing for
+// more information.
+func (c *APIClient) ClientSetPAYGBilling(clientID string, billing PAYGBilling) error {
+	return c.ClientSetPAYGBillingContext(context.Background(), clientID, billing)
+}
+
+// ClientSetPAYGBillingContext is like ClientSetPAYGBilling but uses ctx for the
+// API request.
+func (c *APIClient) ClientSetPAYGBillingContext(ctx context.Context, clientID string, billing PAYGBilling) error {
+	u := fmt.Sprintf("clients/%s/setpaygbilling.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, billing)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// MonthlyScheme is a monthly billing scheme.
+type MonthlyScheme string
+
+const (
+	MonthlyBasic     MonthlyScheme = "Basic"
+	MonthlyUnlimited MonthlyScheme = "Unlimited"
+)
+
+// MonthlyBilling represents the monthly billing settings of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_monthly_billing for
+// more information.
+type MonthlyBilling struct {
+	Currency         string        `json:"Currency"`
+	ClientPays       bool          `json:"ClientPays"`
+	MarkupPercentage int           `json:"MarkupPercentage"`
+	MonthlyScheme    MonthlyScheme `json:"MonthlyScheme,omitempty"`
+}
+
+// ClientSetMonthlyBilling switches a client to monthly billing.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_monthly_billing for
+// more information.
+func (c *APIClient) ClientSetMonthlyBilling(clientID string, billing MonthlyBilling) error {
+	return c.ClientSetMonthlyBillingContext(context.Background(), clientID, billing)
+}
+
+// ClientSetMonthlyBillingContext is like ClientSetMonthlyBilling but uses ctx
+// for the API request.
+func (c *APIClient) ClientSetMonthlyBillingContext(ctx context.Context, clientID string, billing MonthlyBilling) error {
+	u := fmt.Sprintf("clients/%s/setmonthlybilling.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, billing)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// CreditTransfer represents a transfer of credits between the account and a
+// client. A positive Credits amount moves credits to the client, a negative
+// one moves them back to the account.
+//
+// See https://www.campaignmonitor.com/api/clients/#transfer_credits for more
+// information.
+type CreditTransfer struct {
+	Credits                       int  `json:"Credits"`
+	CanUseMyCreditsWhenTheyRunOut bool `json:"CanUseMyCreditsWhenTheyRunOut"`
+}
+
+// CreditBalances represents the credit balances after a CreditTransfer.
+type CreditBalances struct {
+	AccountCredits int `json:"AccountCredits"`
+	ClientCredits  int `json:"ClientCredits"`
+}
+
+// ClientTransferCredits transfers credits between the account and a client
+// and returns the resulting balances.
+//
+// See https://www.campaignmonitor.com/api/clients/#transfer_credits for more
+// information.
+func (c *APIClient) ClientTransferCredits(clientID string, transfer CreditTransfer) (*CreditBalances, error) {
+	return c.ClientTransferCreditsContext(context.Background(), clientID, transfer)
+}
+
+// ClientTransferCreditsContext is like ClientTransferCredits but uses ctx for
+// the API request.
+func (c *APIClient) ClientTransferCreditsContext(ctx context.Context, clientID string, transfer CreditTransfer) (*CreditBalances, error) {
+	u := fmt.Sprintf("clients/%s/credits.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "POST", u, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	var balances CreditBalances
+	err = c.Do(req, &balances)
+	if err != nil {
+		return nil, err
+	}
+	return &balances, nil
+}
+
+// ClientDelete deletes a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#deleting_a_client for more
+// information.
+func (c *APIClient) ClientDelete(clientID string) error {
+	return c.ClientDeleteContext(context.Background(), clientID)
+}
+
+// ClientDeleteContext is like ClientDelete but uses ctx for the API request.
+func (c *APIClient) ClientDeleteContext(ctx context.Context, clientID string) error {
+	u := fmt.Sprintf("clients/%s.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// DefaultSuppressBatchSize is the number of email addresses Suppress sends in
+// a single request unless APIClient.SuppressBatchSize is set. The API does not
+// document a limit for suppress requests; this one keeps each request small
+// enough that a failed batch is cheap to retry.
+const DefaultSuppressBatchSize = 100
+
+// SuppressedEmail represents an entry of a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppression_list for more
+// information.
+type SuppressedEmail struct {
+	SuppressionReason string    `json:"SuppressionReason"`
+	EmailAddress      string    `json:"EmailAddress"`
+	Date              time.Time `json:"-"`
+	State             string    `json:"State"`
+
+	// DateStr holds the API's date, like Subscriber.DateStr.
+	DateStr string `json:"Date"`
+}
+
+// SuppressionListOptions represents the URL parameters that may be used to
+// page through a suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppression_list for more
+// information.
+type SuppressionListOptions struct {
+	Page           int
+	PageSize       int
+	OrderField     string
+	OrderDirection string
+
+	// Prefetch is the number of pages SuppressionListAll fetches ahead of the
+	// caller concurrently. It is not sent to the API.
+	Prefetch int
+}
+
+// SuppressionListResponse represents a single page of a suppression list.
+type SuppressionListResponse struct {
+	Results              []*SuppressedEmail `json:"Results"`
+	ResultsOrderedBy     string             `json:"ResultsOrderedBy"`
+	OrderDirection       string             `json:"OrderDirection"`
+	PageNumber           int                `json:"PageNumber"`
+	PageSize             int                `json:"PageSize"`
+	RecordsOnThisPage    int                `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int                `json:"TotalNumberOfRecords"`
+	NumberOfPages        int                `json:"NumberOfPages"`
+}
+
+// SuppressionList returns a page of a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppression_list for more
+// information.
+func (c *APIClient) SuppressionList(clientID string, opt *SuppressionListOptions) (*SuppressionListResponse, error) {
+	return c.SuppressionListContext(context.Background(), clientID, opt)
+}
+
+// SuppressionListContext is like SuppressionList but uses ctx for the API
+// request.
+func (c *APIClient) SuppressionListContext(ctx context.Context, clientID string, opt *SuppressionListOptions) (*SuppressionListResponse, error) {
+	u := fmt.Sprintf("clients/%s/suppressionlist.json", clientID)
+
+	if opt != nil {
+		v := url.Values{}
+		if opt.Page > 0 {
+			v.Set("page", strconv.Itoa(opt.Page))
+		}
+		if opt.PageSize > 0 {
+			v.Set("pagesize", strconv.Itoa(opt.PageSize))
+		}
+		if opt.OrderField != "" {
+			v.Set("orderfield", opt.OrderField)
+		}
+		if opt.OrderDirection != "" {
+			v.Set("orderdirection", opt.OrderDirection)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
 		}
 	}
 
-	return templates, err
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results SuppressionListResponse
+	err = c.Do(req, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range results.Results {
+		if s.DateStr == "" {
+			continue
+		}
+		s.Date, err = time.Parse("2006-01-02 15:04:05", s.DateStr)
+		if err != nil {
+			return nil, err
+		}
+		s.DateStr = s.Date.Format(time.RFC3339)
+	}
+	return &results, nil
+}
+
+// SuppressedEmailIterator iterates over a client's suppression list, fetching
+// pages from the API as needed. Call Next to advance it, and Err once Next
+// returns false. If the iteration is abandoned before Next returns false,
+// Close must be called to release its resources.
+type SuppressedEmailIterator struct {
+	p       *pager
+	results []*SuppressedEmail
+	cur     *SuppressedEmail
+}
+
+// Next advances the iterator to the next suppressed email address, fetching
+// the next page if needed. It returns false when there are no more entries or
+// an error occurred.
+func (it *SuppressedEmailIterator) Next() bool {
+	for len(it.results) == 0 {
+		page, ok := it.p.nextPage()
+		if !ok {
+			it.cur = nil
+			return false
+		}
+		it.results = page.(*SuppressionListResponse).Results
+	}
+	it.cur, it.results = it.results[0], it.results[1:]
+	return true
+}
+
+// SuppressedEmail returns the current entry.
+func (it *SuppressedEmailIterator) SuppressedEmail() *SuppressedEmail {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any. Entries returned
+// before the error remain valid.
+func (it *SuppressedEmailIterator) Err() error {
+	return it.p.err
+}
+
+// Close stops the iteration, canceling any page requests still in flight.
+func (it *SuppressedEmailIterator) Close() {
+	it.p.close()
+}
+
+// SuppressionListAll returns an iterator over a client's whole suppression
+// list, starting at opt.Page and walking all subsequent pages. opt may be nil.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppression_list for more
+// information.
+func (c *APIClient) SuppressionListAll(clientID string, opt *SuppressionListOptions) *SuppressedEmailIterator {
+	return c.SuppressionListAllContext(context.Background(), clientID, opt)
+}
+
+// SuppressionListAllContext is like SuppressionListAll but uses ctx for the API
+// requests.
+func (c *APIClient) SuppressionListAllContext(ctx context.Context, clientID string, opt *SuppressionListOptions) *SuppressedEmailIterator {
+	var o SuppressionListOptions
+	if opt != nil {
+		o = *opt
+	}
+	fetch := func(ctx context.Context, page int) (interface{}, int, error) {
+		o := o
+		o.Page = page
+		results, err := c.SuppressionListContext(ctx, clientID, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return results, results.NumberOfPages, nil
+	}
+	return &SuppressedEmailIterator{p: newPager(ctx, o.Page, o.Prefetch, fetch)}
+}
+
+// Suppress adds email addresses to a client's suppression list. The addresses
+// are normalized with NormalizeEmail, and an *EmailError is returned before
+// any request is sent if one is not valid. Large inputs are split into
+// requests of up to c.SuppressBatchSize addresses; if one of them fails, the
+// addresses of the preceding requests remain suppressed.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppress_email_addresses for
+// more information.
+func (c *APIClient) Suppress(clientID string, emails ...string) error {
+	return c.SuppressContext(context.Background(), clientID, emails...)
+}
+
+// SuppressContext is like Suppress but uses ctx for the API requests.
+func (c *APIClient) SuppressContext(ctx context.Context, clientID string, emails ...string) error {
+	normalized := make([]string, len(emails))
+	for i, email := range emails {
+		email, err := NormalizeEmail(email)
+		if err != nil {
+			return err
+		}
+		normalized[i] = email
+	}
+	emails = normalized
+	u := fmt.Sprintf("clients/%s/suppress.json", clientID)
+
+	batchSize := c.SuppressBatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultSuppressBatchSize
+	}
+	for start := 0; start < len(emails); start += batchSize {
+		end := start + batchSize
+		if end > len(emails) {
+			end = len(emails)
+		}
+
+		body := struct{ EmailAddresses []string }{emails[start:end]}
+		req, err := c.NewRequestWithContext(ctx, "POST", u, body)
+		if err != nil {
+			return err
+		}
+
+		err = c.Do(req, nil)
+		if err != nil {
+			return fmt.Errorf("suppressing email addresses %d to %d of %d: %w", start+1, end, len(emails), err)
+		}
+	}
+	return nil
+}
+
+// Unsuppress removes an email address from a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#unsuppress_an_email for more
+// information.
+func (c *APIClient) Unsuppress(clientID string, email string) error {
+	return c.UnsuppressContext(context.Background(), clientID, email)
+}
+
+// UnsuppressContext is like Unsuppress but uses ctx for the API request.
+func (c *APIClient) UnsuppressContext(ctx context.Context, clientID string, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("clients/%s/unsuppress.json?%s", clientID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
 }
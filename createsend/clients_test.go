@@ -1,10 +1,14 @@
 package createsend
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListClients(t *testing.T) {
@@ -27,6 +31,24 @@ func TestListClients(t *testing.T) {
 	}
 }
 
+func TestListClientsContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.ListClientsContext(ctx)
+	if err == nil {
+		t.Error("ListClientsContext with canceled context returned no error")
+	}
+}
+
 func TestListLists(t *testing.T) {
 	setup()
 	defer teardown()
@@ -53,7 +75,7 @@ func TestListsForEmail(t *testing.T) {
 
 	mux.HandleFunc("/clients/12ab/listsforemail.json", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testQuerystring(t, r, "email=alice@example.com")
+		testQuerystring(t, r, "email=alice%40example.com")
 		_, _ = fmt.Fprint(w, `[{"ListID": "34cd", "ListName": "mylist", "SubscriberState": "Active"}]`)
 	})
 
@@ -139,3 +161,320 @@ func TestCampaigns(t *testing.T) {
 		t.Errorf("Campaigns return %+v, want %+v", campaigns, want)
 	}
 }
+
+func TestClientCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	basics := ClientBasics{CompanyName: "Acme", Country: "Australia", TimeZone: "(GMT+10:00) Canberra, Melbourne, Sydney"}
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got ClientBasics
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got != basics {
+			t.Errorf("Request body = %+v, want %+v", got, basics)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `"32a381c49a2df99f1d0c6f3c112352b9"`)
+	})
+
+	id, err := client.ClientCreate(basics)
+	if err != nil {
+		t.Errorf("ClientCreate returned error: %v", err)
+	}
+	if id != "32a381c49a2df99f1d0c6f3c112352b9" {
+		t.Errorf("ClientCreate returned %q", id)
+	}
+}
+
+func TestClientDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"ApiKey": "639d8cc27198202f5fe6037a8b17a29a59984b86d3289bc9",
+			"BasicDetails": {
+				"ClientID": "12ab",
+				"CompanyName": "Acme",
+				"ContactName": "Alice",
+				"EmailAddress": "alice@example.com",
+				"Country": "Australia",
+				"TimeZone": "(GMT+10:00) Canberra, Melbourne, Sydney"
+			},
+			"BillingDetails": {
+				"CanPurchaseCredits": true,
+				"Credits": 500,
+				"MarkupOnDesignSpamTest": 0.0,
+				"ClientPays": true,
+				"BaseRatePerRecipient": 1.0,
+				"MarkupPerRecipient": 0.5,
+				"MarkupOnDelivery": 0.0,
+				"BaseDeliveryRate": 5.0,
+				"Currency": "USD",
+				"BaseDesignSpamTestRate": 5.0
+			}
+		}`)
+	})
+
+	details, err := client.ClientDetails("12ab")
+	if err != nil {
+		t.Errorf("ClientDetails returned error: %v", err)
+	}
+
+	want := &ClientDetails{
+		ApiKey: "639d8cc27198202f5fe6037a8b17a29a59984b86d3289bc9",
+		BasicDetails: ClientBasicDetails{
+			ClientID:     "12ab",
+			CompanyName:  "Acme",
+			ContactName:  "Alice",
+			EmailAddress: "alice@example.com",
+			Country:      "Australia",
+			TimeZone:     "(GMT+10:00) Canberra, Melbourne, Sydney",
+		},
+		BillingDetails: ClientBillingDetails{
+			CanPurchaseCredits:     true,
+			Credits:                500,
+			ClientPays:             true,
+			BaseRatePerRecipient:   1.0,
+			MarkupPerRecipient:     0.5,
+			BaseDeliveryRate:       5.0,
+			Currency:               "USD",
+			BaseDesignSpamTestRate: 5.0,
+		},
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("ClientDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestClientUpdateBasics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/setbasics.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	err := client.ClientUpdateBasics("12ab", ClientBasics{CompanyName: "Acme Inc", Country: "Australia", TimeZone: "(GMT+10:00) Canberra, Melbourne, Sydney"})
+	if err != nil {
+		t.Errorf("ClientUpdateBasics returned error: %v", err)
+	}
+}
+
+func TestClientSetPAYGBilling(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/setpaygbilling.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		want := map[string]interface{}{"Currency": "AUD", "CanPurchaseCredits": true, "ClientPays": true, "MarkupPercentage": float64(20)}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+	})
+
+	err := client.ClientSetPAYGBilling("12ab", PAYGBilling{Currency: "AUD", CanPurchaseCredits: true, ClientPays: true, MarkupPercentage: 20})
+	if err != nil {
+		t.Errorf("ClientSetPAYGBilling returned error: %v", err)
+	}
+}
+
+func TestClientSetMonthlyBilling(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/setmonthlybilling.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got MonthlyBilling
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got.MonthlyScheme != MonthlyUnlimited {
+			t.Errorf("Request MonthlyScheme = %q, want %q", got.MonthlyScheme, MonthlyUnlimited)
+		}
+	})
+
+	err := client.ClientSetMonthlyBilling("12ab", MonthlyBilling{Currency: "USD", ClientPays: true, MarkupPercentage: 10, MonthlyScheme: MonthlyUnlimited})
+	if err != nil {
+		t.Errorf("ClientSetMonthlyBilling returned error: %v", err)
+	}
+}
+
+func TestClientTransferCredits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/credits.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got CreditTransfer
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if want := (CreditTransfer{Credits: 200, CanUseMyCreditsWhenTheyRunOut: true}); got != want {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+		_, _ = fmt.Fprint(w, `{"AccountCredits": 800, "ClientCredits": 200}`)
+	})
+
+	balances, err := client.ClientTransferCredits("12ab", CreditTransfer{Credits: 200, CanUseMyCreditsWhenTheyRunOut: true})
+	if err != nil {
+		t.Errorf("ClientTransferCredits returned error: %v", err)
+	}
+
+	want := &CreditBalances{AccountCredits: 800, ClientCredits: 200}
+	if !reflect.DeepEqual(balances, want) {
+		t.Errorf("ClientTransferCredits returned %+v, want %+v", balances, want)
+	}
+}
+
+func TestClientDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	err := client.ClientDelete("12ab")
+	if err != nil {
+		t.Errorf("ClientDelete returned error: %v", err)
+	}
+}
+
+func TestSuppressionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/suppressionlist.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "orderdirection=desc&page=2&pagesize=10")
+		_, _ = fmt.Fprint(w, `{
+			"Results": [
+				{"SuppressionReason": "Unsubscribed", "EmailAddress": "alice@example.com", "Date": "2010-10-26 10:55:31", "State": "Suppressed"}
+			],
+			"ResultsOrderedBy": "email",
+			"OrderDirection": "desc",
+			"PageNumber": 2,
+			"PageSize": 10,
+			"RecordsOnThisPage": 1,
+			"TotalNumberOfRecords": 11,
+			"NumberOfPages": 2
+		}`)
+	})
+
+	list, err := client.SuppressionList("12ab", &SuppressionListOptions{Page: 2, PageSize: 10, OrderDirection: "desc"})
+	if err != nil {
+		t.Errorf("SuppressionList returned error: %v", err)
+	}
+
+	want := &SuppressionListResponse{
+		Results:              []*SuppressedEmail{{
+			SuppressionReason: "Unsubscribed",
+			EmailAddress:      "alice@example.com",
+			Date:              time.Date(2010, 10, 26, 10, 55, 31, 0, time.UTC),
+			State:             "Suppressed",
+			DateStr:           "2010-10-26T10:55:31Z",
+		}},
+		ResultsOrderedBy:     "email",
+		OrderDirection:       "desc",
+		PageNumber:           2,
+		PageSize:             10,
+		RecordsOnThisPage:    1,
+		TotalNumberOfRecords: 11,
+		NumberOfPages:        2,
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("SuppressionList returned %+v, want %+v", list, want)
+	}
+}
+
+func TestSuppressionListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/suppressionlist.json", func(w http.ResponseWriter, r *http.Request) {
+		page := r.FormValue("page")
+		_, _ = fmt.Fprintf(w, `{"Results": [{"EmailAddress": "user%s@example.com"}], "PageNumber": %s, "NumberOfPages": 3}`, page, page)
+	})
+
+	it := client.SuppressionListAll("12ab", nil)
+	defer it.Close()
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.SuppressedEmail().EmailAddress)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("SuppressionListAll returned error: %v", err)
+	}
+
+	want := []string{"user1@example.com", "user2@example.com", "user3@example.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SuppressionListAll returned %v, want %v", got, want)
+	}
+}
+
+func TestSuppress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var batches [][]string
+	mux.HandleFunc("/clients/12ab/suppress.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body struct{ EmailAddresses []string }
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		batches = append(batches, body.EmailAddresses)
+	})
+
+	client.SuppressBatchSize = 3
+	emails := make([]string, 4)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("user%d@example.com", i)
+	}
+	emails[0] = " user0@Example.COM"
+
+	err := client.Suppress("12ab", emails...)
+	if err != nil {
+		t.Errorf("Suppress returned error: %v", err)
+	}
+	if len(batches) != 2 || len(batches[0]) != 3 || len(batches[1]) != 1 {
+		t.Fatalf("Suppress sent %d batches, want 2 batches of 3 and 1 addresses", len(batches))
+	}
+	if batches[0][0] != "user0@example.com" {
+		t.Errorf("Suppress sent %q, want the normalized %q", batches[0][0], "user0@example.com")
+	}
+	if batches[1][0] != emails[3] {
+		t.Errorf("Suppress sent %q in the last batch, want %q", batches[1][0], emails[3])
+	}
+}
+
+func TestSuppressFail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/suppress.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{"Code": 1, "Message": "Invalid Email Address"}`)
+	})
+
+	err := client.Suppress("12ab", "alice@example.com")
+	var e *Error
+	if !errors.As(err, &e) || e.Code != CodeInvalidEmailAddress {
+		t.Errorf("Suppress returned error %v, want createsend error %d", err, CodeInvalidEmailAddress)
+	}
+}
+
+func TestUnsuppress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/unsuppress.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testQuerystring(t, r, "email=alice%2Btag%40example.com")
+	})
+
+	err := client.Unsuppress("12ab", "alice+tag@example.com")
+	if err != nil {
+		t.Errorf("Unsuppress returned error: %v", err)
+	}
+}
@@ -2,13 +2,12 @@ package createsend
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
-	"time"
 )
 
 const (
@@ -30,16 +29,25 @@ type APIClient struct {
 
 	// Log is used to log debugging messages, if set.
 	Log *log.Logger
+
+	// Retry configures automatic retries of requests that fail with a
+	// transient error. If nil, each request is sent only once.
+	Retry *RetryPolicy
+
+	// SuppressBatchSize is the maximum number of email addresses Suppress
+	// sends in a single request. If zero, DefaultSuppressBatchSize is used.
+	SuppressBatchSize int
 }
 
 // NewAPIClient returns a new Campaign Monitor API client. If a nil httpClient
-// is provided, http.DefaultClient will be used. To use API methods which
-// require authentication, provide an http.Client that will perform the
-// authentication for you (such as that provided by the goauth2 library).
+// is provided, a new http.Client with no timeout will be used; deadlines and
+// cancellation are then controlled per call by the context passed to the
+// *Context methods. To use API methods which require authentication, provide
+// an http.Client that will perform the authentication for you, using either
+// APIKeyAuthTransport or OAuthTransport.
 func NewAPIClient(httpClient *http.Client) *APIClient {
 	if httpClient == nil {
-		httpClient = http.DefaultClient
-		httpClient.Timeout = 5 * time.Second
+		httpClient = &http.Client{}
 	}
 	baseURL, _ := url.Parse(defaultBaseURL)
 
@@ -53,6 +61,13 @@ func NewAPIClient(httpClient *http.Client) *APIClient {
 // specified, the value pointed to by body is JSON encoded and included as the
 // request body.
 func (c *APIClient) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext is like NewRequest but creates the request with ctx.
+// The context controls the entire lifetime of the request and its response:
+// obtaining a connection, sending the request, and reading the response.
+func (c *APIClient) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -68,7 +83,7 @@ func (c *APIClient) NewRequest(method, urlStr string, body interface{}) (*http.R
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -77,47 +92,51 @@ func (c *APIClient) NewRequest(method, urlStr string, body interface{}) (*http.R
 	return req, nil
 }
 
-type Error struct {
-	Code       int
-	Message    string
-	ResultData interface{}
-}
-
-func (e *Error) Error() string {
-	return fmt.Sprintf("%s (createsend error %d)", e.Message, e.Code)
+// DoContext is like Do but sends req with ctx as its context, replacing the
+// context the request was created with.
+func (c *APIClient) DoContext(ctx context.Context, req *http.Request, v interface{}) error {
+	return c.Do(req.WithContext(ctx), v)
 }
 
 // Do sends an API request and returns the API response. The API response is
-// decoded and stored in the value pointed to by v, or returned as an error if
-// an API error has occurred.
+// decoded and stored in the value pointed to by v, or returned as an *Error if
+// the API responded with a non-2xx status code. If the response has no body, v
+// is left untouched. The request is canceled when its context is, and
+// retried according to c.Retry if it fails with a transient error.
 func (c *APIClient) Do(req *http.Request, v interface{}) error {
-	resp, err := c.client.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return err
 	}
 
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusBadRequest {
-		var e Error
-		err = json.NewDecoder(resp.Body).Decode(&e)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
 			return err
 		}
-		return &e
-	} else if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		if c.Log != nil {
-			body, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				log.Printf("ReadAll failed: %s", err.Error())
-			}
 			c.Log.Printf("http response %d body:\n%s", resp.StatusCode, body)
 		}
-		return fmt.Errorf("http response status code %d", resp.StatusCode)
+		return newError(req, resp.StatusCode, body)
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	// A short read here means the response was truncated, as opposed to
+	// intentionally empty.
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
 	}
 
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
+	// Some endpoints respond with 201 Created, 204 No Content or 200 OK and
+	// no body at all. Leave v untouched in that case.
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
 	}
-	return err
+	return json.NewDecoder(bytes.NewReader(body)).Decode(v)
 }
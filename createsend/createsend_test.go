@@ -1,14 +1,18 @@
 package createsend
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -77,6 +81,12 @@ func TestNewAPIClient(t *testing.T) {
 	if c.UserAgent != userAgent {
 		t.Errorf("NewAPIClient UserAgent = %v, want %v", c.UserAgent, userAgent)
 	}
+	if c.client == http.DefaultClient {
+		t.Error("NewAPIClient used http.DefaultClient, want a new http.Client")
+	}
+	if http.DefaultClient.Timeout != 0 {
+		t.Errorf("NewAPIClient modified http.DefaultClient Timeout = %v", http.DefaultClient.Timeout)
+	}
 }
 
 func TestNewRequest(t *testing.T) {
@@ -104,6 +114,20 @@ func TestNewRequest(t *testing.T) {
 	}
 }
 
+func TestNewRequestWithContext(t *testing.T) {
+	c := NewAPIClient(nil)
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "v")
+	req, err := c.NewRequestWithContext(ctx, "GET", "foo", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext returned error: %v", err)
+	}
+	if req.Context() != ctx {
+		t.Errorf("NewRequestWithContext Context = %v, want %v", req.Context(), ctx)
+	}
+}
+
 func TestNewRequest_invalidJSON(t *testing.T) {
 	c := NewAPIClient(nil)
 
@@ -149,6 +173,60 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_emptyBody(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusCreated, http.StatusNoContent} {
+		t.Run(fmt.Sprint(status), func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			})
+
+			req, _ := client.NewRequest("POST", "/", nil)
+			body := "unchanged"
+			if err := client.Do(req, &body); err != nil {
+				t.Errorf("Do returned error: %v", err)
+			}
+			if body != "unchanged" {
+				t.Errorf("Do modified body to %q", body)
+			}
+		})
+	}
+}
+
+func TestDo_truncatedBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		_, _ = fmt.Fprint(w, `{"A":`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	var body struct{ A string }
+	err := client.Do(req, &body)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Do returned error %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestDo_invalidJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"A":`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	var body struct{ A string }
+	if err := client.Do(req, &body); err == nil {
+		t.Error("Expected error to be returned.")
+	}
+}
+
 func TestDo_httpError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -165,6 +243,47 @@ func TestDo_httpError(t *testing.T) {
 	}
 }
 
+func TestDoContext_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request was sent with a canceled context")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	err := client.DoContext(ctx, req, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DoContext returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDo_deadlineExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	err := client.Do(req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
 // Test handling of an error caused by the internal http client's Do() function.
 // A redirect loop is pretty unlikely to occur within the Campaign Monitor API,
 // but does allow us to exercise the right code path.
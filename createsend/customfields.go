@@ -0,0 +1,275 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// customFieldDateFormat is the format of Date custom field values.
+const customFieldDateFormat = "2006/01/02"
+
+// CustomFieldError is returned when a custom field value does not match the
+// definition of the field.
+type CustomFieldError struct {
+	Key      string
+	DataType DataType // empty if the list has no field with that key
+	Value    interface{}
+	Reason   string
+}
+
+func (e *CustomFieldError) Error() string {
+	if e.DataType == "" {
+		return fmt.Sprintf("createsend: custom field %s: %s", e.Key, e.Reason)
+	}
+	return fmt.Sprintf("createsend: %s custom field %s: %s", e.DataType, e.Key, e.Reason)
+}
+
+// ClearCustomField returns a CustomField that removes the value of the field
+// with the given key when updating a subscriber.
+func ClearCustomField(key string) CustomField {
+	return CustomField{Key: key, Value: "", Clear: true}
+}
+
+// CustomFieldSchema validates, encodes and decodes subscriber custom field
+// values according to the definitions of a list's custom fields.
+//
+// Definitions name fields by keys in brackets, such as "[Website]", whereas
+// subscribers name them without; a schema accepts both forms.
+type CustomFieldSchema struct {
+	defs map[string]CustomFieldDefinition
+}
+
+// NewCustomFieldSchema returns a schema for the given definitions, as returned
+// by ListCustomFields.
+func NewCustomFieldSchema(defs []CustomFieldDefinition) *CustomFieldSchema {
+	s := &CustomFieldSchema{defs: make(map[string]CustomFieldDefinition, len(defs))}
+	for _, d := range defs {
+		s.defs[CustomFieldKey(d.Key)] = d
+	}
+	return s
+}
+
+// CustomFieldKey returns a custom field key without the brackets of
+// definition keys, so that "[Website]" and "Website" both become "Website".
+func CustomFieldKey(key string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(key, "["), "]")
+}
+
+// Definition returns the definition of the field with the given key.
+func (s *CustomFieldSchema) Definition(key string) (CustomFieldDefinition, bool) {
+	d, ok := s.defs[CustomFieldKey(key)]
+	return d, ok
+}
+
+// Encode returns the custom fields that set the field with the given key to
+// value, or a *CustomFieldError if value does not suit the field's DataType:
+//
+//	Text, Country, USState  a string
+//	Number                  an integer or floating-point number, or a string holding one
+//	Date                    a time.Time, or a string in YYYY/MM/DD or YYYY-MM-DD format
+//	MultiSelectOne          a string among the field's options
+//	MultiSelectMany         a string or []string among the field's options
+//
+// A MultiSelectMany field is encoded as one CustomField per selected option,
+// which is how the API expects several values of the same field.
+func (s *CustomFieldSchema) Encode(key string, value interface{}) ([]CustomField, error) {
+	d, ok := s.Definition(key)
+	if !ok {
+		return nil, &CustomFieldError{Key: key, Value: value, Reason: "no such field"}
+	}
+
+	if d.DataType == MultiSelectMany {
+		if vs, ok := value.([]string); ok {
+			fields := make([]CustomField, 0, len(vs))
+			for _, v := range vs {
+				ev, err := encodeCustomFieldValue(d, key, v)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, CustomField{Key: key, Value: ev})
+			}
+			return fields, nil
+		}
+	}
+
+	ev, err := encodeCustomFieldValue(d, key, value)
+	if err != nil {
+		return nil, err
+	}
+	return []CustomField{{Key: key, Value: ev}}, nil
+}
+
+// Validate checks fields, such as those of a NewSubscriber, against the
+// schema. It returns the first *CustomFieldError found, if any. Fields with
+// Clear set are only checked for their key.
+func (s *CustomFieldSchema) Validate(fields []CustomField) error {
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		d, ok := s.Definition(f.Key)
+		if !ok {
+			return &CustomFieldError{Key: f.Key, Value: f.Value, Reason: "no such field"}
+		}
+		if f.Clear {
+			continue
+		}
+		k := CustomFieldKey(f.Key)
+		if seen[k] && d.DataType != MultiSelectMany {
+			return &CustomFieldError{Key: f.Key, DataType: d.DataType, Value: f.Value, Reason: "more than one value"}
+		}
+		seen[k] = true
+		if _, err := encodeCustomFieldValue(d, f.Key, f.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode converts the custom fields of a subscriber into Go values keyed by
+// field key without brackets: a string for Text, Country, USState and
+// MultiSelectOne fields, a float64 for Number fields, a time.Time for Date
+// fields and a []string holding every selected option for MultiSelectMany
+// fields. Fields missing from the schema are kept with their raw value, and
+// empty Number and Date values are left out.
+func (s *CustomFieldSchema) Decode(fields []CustomField) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		k := CustomFieldKey(f.Key)
+		d, ok := s.defs[k]
+		if !ok {
+			values[k] = f.Value
+			continue
+		}
+
+		str, isStr := f.Value.(string)
+		switch d.DataType {
+		case Number:
+			switch v := f.Value.(type) {
+			case float64:
+				values[k] = v
+			case string:
+				if v == "" {
+					continue
+				}
+				n, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					return nil, &CustomFieldError{Key: f.Key, DataType: d.DataType, Value: f.Value, Reason: "not a number"}
+				}
+				values[k] = n
+			default:
+				return nil, &CustomFieldError{Key: f.Key, DataType: d.DataType, Value: f.Value, Reason: "not a number"}
+			}
+		case Date:
+			if !isStr {
+				return nil, &CustomFieldError{Key: f.Key, DataType: d.DataType, Value: f.Value, Reason: "not a date"}
+			}
+			if str == "" {
+				continue
+			}
+			t, err := parseCustomFieldDate(str)
+			if err != nil {
+				return nil, &CustomFieldError{Key: f.Key, DataType: d.DataType, Value: f.Value, Reason: "not a date in YYYY/MM/DD format"}
+			}
+			values[k] = t
+		case MultiSelectMany:
+			vs, _ := values[k].([]string)
+			values[k] = append(vs, fmt.Sprint(f.Value))
+		default:
+			if isStr {
+				values[k] = str
+			} else {
+				values[k] = fmt.Sprint(f.Value)
+			}
+		}
+	}
+	return values, nil
+}
+
+// encodeCustomFieldValue validates a single value for the field defined by d
+// and returns it in the form the API expects.
+func encodeCustomFieldValue(d CustomFieldDefinition, key string, value interface{}) (interface{}, error) {
+	fail := func(reason string) (interface{}, error) {
+		return nil, &CustomFieldError{Key: key, DataType: d.DataType, Value: value, Reason: reason}
+	}
+
+	switch d.DataType {
+	case Number:
+		switch v := value.(type) {
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				return fail("not a number")
+			}
+			return v, nil
+		case json.Number:
+			return v, nil
+		}
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return value, nil
+		}
+		return fail("not a number")
+	case Date:
+		switch v := value.(type) {
+		case time.Time:
+			return v.Format(customFieldDateFormat), nil
+		case string:
+			t, err := parseCustomFieldDate(v)
+			if err != nil {
+				return fail("not a date in YYYY/MM/DD format")
+			}
+			return t.Format(customFieldDateFormat), nil
+		}
+		return fail("not a date")
+	case MultiSelectOne, MultiSelectMany:
+		v, ok := value.(string)
+		if !ok {
+			return fail("not a string")
+		}
+		for _, o := range d.FieldOptions {
+			if o == v {
+				return v, nil
+			}
+		}
+		return fail(fmt.Sprintf("%q is not one of the field's options", v))
+	default:
+		v, ok := value.(string)
+		if !ok {
+			return fail("not a string")
+		}
+		return v, nil
+	}
+}
+
+// comparableCustomFieldValue returns value in a canonical form for the field
+// defined by d, so that equal dates or numbers written differently, such as
+// "2010/10/25" and "2010-10-25" or "1" and "1.0", compare equal.
+func comparableCustomFieldValue(d CustomFieldDefinition, value interface{}) string {
+	s := fmt.Sprint(value)
+	switch d.DataType {
+	case Date:
+		if t, ok := value.(time.Time); ok {
+			return t.Format(customFieldDateFormat)
+		}
+		if t, err := parseCustomFieldDate(s); err == nil {
+			return t.Format(customFieldDateFormat)
+		}
+	case Number:
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	}
+	return s
+}
+
+func parseCustomFieldDate(s string) (time.Time, error) {
+	t, err := time.Parse(customFieldDateFormat, s)
+	if err != nil {
+		return time.Parse("2006-01-02", s)
+	}
+	return t, nil
+}
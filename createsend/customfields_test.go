@@ -0,0 +1,143 @@
+package createsend
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testSchema = NewCustomFieldSchema([]CustomFieldDefinition{
+	{FieldName: "website", Key: "[website]", DataType: Text},
+	{FieldName: "age", Key: "[age]", DataType: Number},
+	{FieldName: "birthday", Key: "[birthday]", DataType: Date},
+	{FieldName: "plan", Key: "[plan]", DataType: MultiSelectOne, FieldOptions: []string{"Free", "Pro"}},
+	{FieldName: "interests", Key: "[interests]", DataType: MultiSelectMany, FieldOptions: []string{"Go", "Rust", "Zig"}},
+	{FieldName: "country", Key: "[country]", DataType: Country},
+})
+
+func TestCustomFieldSchemaEncode(t *testing.T) {
+	tests := []struct {
+		key   string
+		value interface{}
+		want  []CustomField
+	}{
+		{"website", "http://example.com", []CustomField{{Key: "website", Value: "http://example.com"}}},
+		{"[age]", 42, []CustomField{{Key: "[age]", Value: 42}}},
+		{"age", "4.5", []CustomField{{Key: "age", Value: "4.5"}}},
+		{"birthday", time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC), []CustomField{{Key: "birthday", Value: "1990/05/17"}}},
+		{"birthday", "1990-05-17", []CustomField{{Key: "birthday", Value: "1990/05/17"}}},
+		{"plan", "Pro", []CustomField{{Key: "plan", Value: "Pro"}}},
+		{"interests", []string{"Go", "Zig"}, []CustomField{{Key: "interests", Value: "Go"}, {Key: "interests", Value: "Zig"}}},
+		{"interests", "Rust", []CustomField{{Key: "interests", Value: "Rust"}}},
+	}
+	for _, tt := range tests {
+		got, err := testSchema.Encode(tt.key, tt.value)
+		if err != nil {
+			t.Errorf("Encode(%q, %v) returned error: %v", tt.key, tt.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Encode(%q, %v) = %+v, want %+v", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestCustomFieldSchemaEncode_invalid(t *testing.T) {
+	tests := []struct {
+		key   string
+		value interface{}
+	}{
+		{"missing", "x"},
+		{"website", 3},
+		{"age", "old"},
+		{"age", true},
+		{"birthday", "17/05/1990"},
+		{"plan", "Enterprise"},
+		{"interests", []string{"Go", "Java"}},
+		{"country", nil},
+	}
+	for _, tt := range tests {
+		_, err := testSchema.Encode(tt.key, tt.value)
+		var cfErr *CustomFieldError
+		if !errors.As(err, &cfErr) {
+			t.Errorf("Encode(%q, %v) returned error %v, want a *CustomFieldError", tt.key, tt.value, err)
+		}
+	}
+}
+
+func TestCustomFieldSchemaValidate(t *testing.T) {
+	valid := []CustomField{
+		{Key: "website", Value: "http://example.com"},
+		{Key: "interests", Value: "Go"},
+		{Key: "interests", Value: "Rust"},
+		ClearCustomField("birthday"),
+	}
+	if err := testSchema.Validate(valid); err != nil {
+		t.Errorf("Validate returned error: %v", err)
+	}
+
+	invalid := [][]CustomField{
+		{{Key: "plan", Value: "Free"}, {Key: "plan", Value: "Pro"}},
+		{{Key: "age", Value: "many"}},
+		{ClearCustomField("missing")},
+	}
+	for _, fields := range invalid {
+		if err := testSchema.Validate(fields); err == nil {
+			t.Errorf("Validate(%+v) returned no error", fields)
+		}
+	}
+}
+
+func TestCustomFieldSchemaDecode(t *testing.T) {
+	var sub Subscriber
+	err := json.Unmarshal([]byte(`{"CustomFields": [
+		{"Key": "website", "Value": "http://example.com"},
+		{"Key": "age", "Value": "42"},
+		{"Key": "birthday", "Value": "1990/05/17"},
+		{"Key": "plan", "Value": "Pro"},
+		{"Key": "interests", "Value": "Go"},
+		{"Key": "interests", "Value": "Zig"},
+		{"Key": "removed", "Value": "kept as is"}
+	]}`), &sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := testSchema.Decode(sub.CustomFields)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"website":   "http://example.com",
+		"age":       42.0,
+		"birthday":  time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		"plan":      "Pro",
+		"interests": []string{"Go", "Zig"},
+		"removed":   "kept as is",
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("Decode returned %+v, want %+v", values, want)
+	}
+}
+
+func TestCustomFieldSchemaDecode_invalid(t *testing.T) {
+	_, err := testSchema.Decode([]CustomField{{Key: "birthday", Value: "yesterday"}})
+	var cfErr *CustomFieldError
+	if !errors.As(err, &cfErr) || cfErr.DataType != Date {
+		t.Errorf("Decode returned error %v, want a Date *CustomFieldError", err)
+	}
+}
+
+func TestCustomFieldClearEncoding(t *testing.T) {
+	b, err := json.Marshal([]CustomField{{Key: "website", Value: "x"}, ClearCustomField("age")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"Key":"website","Value":"x"},{"Key":"age","Value":"","Clear":true}]`
+	if string(b) != want {
+		t.Errorf("Marshal returned %s, want %s", b, want)
+	}
+}
@@ -0,0 +1,255 @@
+package createsend
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// EmailError is returned for an email address that is not valid. Methods
+// that identify a subscriber, person or administrator by email address, such
+// as GetSubscriber, ListsForEmail, PersonDetails and SetPrimaryContact,
+// normalize the address with NormalizeEmail and return an *EmailError before
+// sending any request.
+type EmailError struct {
+	Email  string
+	Reason string
+}
+
+func (e *EmailError) Error() string {
+	return fmt.Sprintf("createsend: invalid email address %q: %s", e.Email, e.Reason)
+}
+
+// Limits on the length of email addresses, in octets, from RFC 5321.
+const (
+	maxEmailLength       = 254
+	maxLocalPartLength   = 64
+	maxDomainLength      = 253
+	maxDomainLabelLength = 63
+)
+
+// NormalizeEmail checks that email is a plain address such as
+// "alice@example.com" and returns it in normalized form: without surrounding
+// whitespace, and with the domain in lower case. Internationalized domain
+// names are converted to their ASCII (Punycode) form, so that "bob@Bücher.de"
+// becomes "bob@xn--bcher-kva.de". The local part is kept as is, since mail
+// servers may treat it as case sensitive.
+//
+// The local part must be an RFC 5322 dot-atom of ASCII characters; quoted
+// local parts, comments, display names and domain literals are rejected.
+// Domain names are lower-cased but not otherwise mapped as IDNA prescribes.
+//
+// It returns an *EmailError if email is not valid.
+func NormalizeEmail(email string) (string, error) {
+	fail := func(reason string) (string, error) {
+		return "", &EmailError{Email: email, Reason: reason}
+	}
+
+	addr := strings.TrimSpace(email)
+	if addr == "" {
+		return fail("empty")
+	}
+	at := strings.LastIndexByte(addr, '@')
+	if at < 0 {
+		return fail("missing @")
+	}
+	local, domain := addr[:at], addr[at+1:]
+
+	if reason := checkLocalPart(local); reason != "" {
+		return fail(reason)
+	}
+	domain, reason := normalizeDomain(domain)
+	if reason != "" {
+		return fail(reason)
+	}
+
+	addr = local + "@" + domain
+	if len(addr) > maxEmailLength {
+		return fail(fmt.Sprintf("longer than %d characters", maxEmailLength))
+	}
+	return addr, nil
+}
+
+// ValidateEmail returns an *EmailError if email is not valid. See
+// NormalizeEmail for what is considered valid.
+func ValidateEmail(email string) error {
+	_, err := NormalizeEmail(email)
+	return err
+}
+
+// checkLocalPart checks that local is a dot-atom, and returns the reason it is
+// not valid, if any.
+func checkLocalPart(local string) string {
+	switch {
+	case local == "":
+		return "empty local part"
+	case len(local) > maxLocalPartLength:
+		return fmt.Sprintf("local part longer than %d characters", maxLocalPartLength)
+	case local[0] == '.' || local[len(local)-1] == '.':
+		return "local part starts or ends with a dot"
+	case strings.Contains(local, ".."):
+		return "local part contains consecutive dots"
+	}
+	for i := 0; i < len(local); i++ {
+		if c := local[i]; c != '.' && !isAtext(c) {
+			return fmt.Sprintf("invalid character %q in local part", rune(c))
+		}
+	}
+	return ""
+}
+
+// isAtext reports whether c is an RFC 5322 atom character.
+func isAtext(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) >= 0
+}
+
+// normalizeDomain returns domain in lower case and with internationalized
+// labels in Punycode, or the reason it is not a valid domain name.
+func normalizeDomain(domain string) (string, string) {
+	if domain == "" {
+		return "", "empty domain"
+	}
+	if !utf8.ValidString(domain) {
+		return "", "domain is not valid UTF-8"
+	}
+	// Ideographic and full-width full stops separate labels too.
+	domain = strings.NewReplacer("。", ".", "．", ".", "｡", ".").Replace(domain)
+	if utf8.RuneCountInString(domain) > maxDomainLength {
+		return "", fmt.Sprintf("domain longer than %d characters", maxDomainLength)
+	}
+
+	labels := strings.Split(strings.ToLower(domain), ".")
+	if len(labels) < 2 {
+		return "", "domain has no top-level domain"
+	}
+	for i, label := range labels {
+		if label == "" {
+			return "", "domain contains an empty label"
+		}
+		ascii := true
+		for _, r := range label {
+			if r >= utf8.RuneSelf {
+				ascii = false
+				break
+			}
+		}
+		if !ascii {
+			label = "xn--" + punycode(label)
+			labels[i] = label
+		}
+		if len(label) > maxDomainLabelLength {
+			return "", fmt.Sprintf("domain label longer than %d characters", maxDomainLabelLength)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return "", "domain label starts or ends with a hyphen"
+		}
+		for j := 0; j < len(label); j++ {
+			if c := label[j]; !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-') {
+				return "", fmt.Sprintf("invalid character %q in domain", rune(c))
+			}
+		}
+	}
+
+	domain = strings.Join(labels, ".")
+	if len(domain) > maxDomainLength {
+		return "", fmt.Sprintf("domain longer than %d characters", maxDomainLength)
+	}
+	return domain, ""
+}
+
+// Punycode parameters, from RFC 3492.
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// punycode encodes a domain label as described in RFC 3492, without the
+// "xn--" prefix. RFC 3492 guards against delta overflowing 26-bit integers;
+// normalizeDomain limits labels to maxDomainLength runes, which keeps delta
+// below utf8.MaxRune * (maxDomainLength + 1), well within an int.
+func punycode(s string) string {
+	runes := []rune(s)
+	var out []byte
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out = append(out, byte(r))
+		}
+	}
+	b := len(out)
+	if b > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for h := b; h < len(runes); {
+		m := int(utf8.MaxRune) + 1
+		for _, r := range runes {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := k - bias
+				if t < punyTMin {
+					t = punyTMin
+				} else if t > punyTMax {
+					t = punyTMax
+				}
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return string(out)
+}
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > (punyBase-punyTMin)*punyTMax/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
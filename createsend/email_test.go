@@ -0,0 +1,147 @@
+package createsend
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"alice@example.com", "alice@example.com"},
+		{"  Alice+News@Example.COM\n", "Alice+News@example.com"},
+		{"o'brien.x@sub.example.co.uk", "o'brien.x@sub.example.co.uk"},
+		{"bob@Bücher.de", "bob@xn--bcher-kva.de"},
+		{"bob@münchen.de", "bob@xn--mnchen-3ya.de"},
+		{"carol@例え。テスト", "carol@xn--r8jz45g.xn--zckzah"},
+		{"dave@example.中国", "dave@example.xn--fiqs8s"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeEmail(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizeEmail(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestPunycode(t *testing.T) {
+	// Sample strings from RFC 3492, section 7.1, and mixed-script labels.
+	tests := []struct {
+		in, want string
+	}{
+		{"\u0644\u064a\u0647\u0645\u0627\u0628\u062a\u0643\u0644\u0645\u0648\u0634\u0639\u0631\u0628\u064a\u061f", "egbpdaj6bu4bxfgehfvwxn"},
+		{"\u4ed6\u4eec\u4e3a\u4ec0\u4e48\u4e0d\u8bf4\u4e2d\u6587", "ihqwcrb4cv8a8dqg056pqjye"},
+		{"\u4ed6\u5011\u7232\u4ec0\u9ebd\u4e0d\u8aaa\u4e2d\u6587", "ihqwctvzc91f659drss3x8bo0yb"},
+		{"Pro\u010dprost\u011bnemluv\u00ed\u010desky", "Proprostnemluvesky-uyb24dma41a"},
+		{"3\u5e74B\u7d44\u91d1\u516b\u5148\u751f", "3B-ww4c5e180e575a65lsy2b"},
+		{"\u5b89\u5ba4\u5948\u7f8e\u6075-with-SUPER-MONKEYS", "-with-SUPER-MONKEYS-pc58ag80a8qai00g7n9n"},
+		{"Maji\u3067Koi\u3059\u308b5\u79d2\u524d", "MajiKoi5-783gue6qz075azm5e"},
+		{"\u305d\u306e\u30b9\u30d4\u30fc\u30c9\u3067", "d9juau41awczczp"},
+		{"b\u00fccher", "bcher-kva"},
+		{"a\u00fc-b", "a-b-hoa"},
+		{"\u00ff\U0010ffff", "wda62883t"},
+	}
+	for _, tt := range tests {
+		if got := punycode(tt.in); got != tt.want {
+			t.Errorf("punycode(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeEmail_invalid(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"   ",
+		"alice",
+		"alice@",
+		"@example.com",
+		"alice@localhost",
+		"alice@example..com",
+		"alice@-example.com",
+		"alice@exa_mple.com",
+		".alice@example.com",
+		"al..ice@example.com",
+		"al ice@example.com",
+		`"alice"@example.com`,
+		"Alice <alice@example.com>",
+		"alice@[127.0.0.1]",
+		"ålice@example.com",
+		strings.Repeat("a", 65) + "@example.com",
+		"alice@" + strings.Repeat("a", 64) + ".com",
+		"alice@" + strings.Repeat("\u00fc", 60) + ".com",
+	} {
+		_, err := NormalizeEmail(in)
+		var e *EmailError
+		if !errors.As(err, &e) {
+			t.Errorf("NormalizeEmail(%q) returned %v, want an *EmailError", in, err)
+		}
+	}
+}
+
+func TestGetSubscriber_escapesEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testQuerystring(t, r, "email=alice%2Bnews%40example.com")
+		_, _ = w.Write([]byte(`{"EmailAddress": "alice+news@example.com"}`))
+	})
+
+	sub, err := client.GetSubscriber("12CD", " alice+news@Example.com")
+	if err != nil {
+		t.Fatalf("GetSubscriber returned error: %v", err)
+	}
+	if sub.EmailAddress != "alice+news@example.com" {
+		t.Errorf("GetSubscriber returned %+v", sub)
+	}
+}
+
+func TestDeleteSubscriber_invalidEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("DeleteSubscriber sent a request for an invalid email address")
+	})
+
+	err := client.DeleteSubscriber("12CD", "alice@example.com&email=bob@example.com")
+	var e *EmailError
+	if !errors.As(err, &e) {
+		t.Errorf("DeleteSubscriber returned %v, want an *EmailError", err)
+	}
+}
+
+func TestEmailKeyedCalls_invalidEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Request sent for an invalid email address: %s %s", r.Method, r.URL)
+	})
+
+	const bad = "alice@example.com&email=bob@example.com"
+	calls := map[string]func() error{
+		"PersonDetails":           func() error { _, err := client.PersonDetails("12ab", bad); return err },
+		"UpdatePerson":            func() error { return client.UpdatePerson("12ab", bad, NewPerson{}) },
+		"DeletePerson":            func() error { return client.DeletePerson("12ab", bad) },
+		"SetClientPrimaryContact": func() error { return client.SetClientPrimaryContact("12ab", bad) },
+		"AdministratorDetails":    func() error { _, err := client.AdministratorDetails(bad); return err },
+		"UpdateAdministrator":     func() error { return client.UpdateAdministrator(bad, NewAdministrator{}) },
+		"DeleteAdministrator":     func() error { return client.DeleteAdministrator(bad) },
+		"SetPrimaryContact":       func() error { return client.SetPrimaryContact(bad) },
+		"Unsuppress":              func() error { return client.Unsuppress("12ab", bad) },
+		"Suppress":                func() error { return client.Suppress("12ab", "alice@example.com", bad) },
+		"UpdateSubscriber": func() error {
+			return client.UpdateSubscriber("12ab", "alice@example.com", NewSubscriber{EmailAddress: bad})
+		},
+	}
+	for name, call := range calls {
+		var e *EmailError
+		if err := call(); !errors.As(err, &e) {
+			t.Errorf("%s returned %v, want an *EmailError", name, err)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package createsend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Campaign Monitor error codes that callers commonly need to handle. See
+// https://www.campaignmonitor.com/api/getting-started/#response-status-codes
+// for more information.
+const (
+	CodeInvalidEmailAddress = 1
+	CodeInvalidAPIKey       = 100
+	CodeExpiredOAuthToken   = 121
+	CodeSubscriberNotInList = 203
+	CodeImportFailures      = 210
+)
+
+// Sentinel errors that an *Error matches with errors.Is.
+var (
+	// ErrNotFound matches errors for responses with HTTP status 404.
+	ErrNotFound = errors.New("createsend: not found")
+
+	// ErrRateLimited matches errors for responses with HTTP status 429.
+	ErrRateLimited = errors.New("createsend: rate limited")
+
+	// ErrUnauthorized matches errors for responses with HTTP status 401,
+	// such as those caused by an invalid API key or an expired OAuth token.
+	ErrUnauthorized = errors.New("createsend: unauthorized")
+
+	// ErrSubscriberNotInList matches errors with Campaign Monitor error code
+	// 203, returned when looking up an email address that is not in a list.
+	ErrSubscriberNotInList = errors.New("createsend: subscriber not in list")
+)
+
+// Error is an error returned by the Campaign Monitor API. APIClient.Do returns
+// an *Error for every response with a non-2xx status code.
+//
+// See https://www.campaignmonitor.com/api/getting-started/#response-status-codes
+// for more information.
+type Error struct {
+	// Code is the Campaign Monitor error code, or 0 if the response body did
+	// not contain one.
+	Code int
+
+	// Message is the error message returned by the API, or the HTTP status
+	// text if the response body did not contain one.
+	Message string
+
+	// ResultData holds the additional data that some errors carry, such as
+	// the results of a partially failed import, decoded as generic JSON. Use
+	// DecodeResultData to decode it into a typed value instead.
+	ResultData interface{}
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+
+	// Method and URL identify the request that failed.
+	Method string `json:"-"`
+	URL    string `json:"-"`
+
+	rawResultData json.RawMessage
+}
+
+func (e *Error) Error() string {
+	if e.Code == 0 {
+		return fmt.Sprintf("%s (http status %d)", e.Message, e.StatusCode)
+	}
+	return fmt.Sprintf("%s (createsend error %d)", e.Message, e.Code)
+}
+
+// Is reports whether e matches one of the sentinel errors of this package.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrSubscriberNotInList:
+		return e.Code == CodeSubscriberNotInList
+	}
+	return false
+}
+
+// DecodeResultData decodes the error's ResultData into the value pointed to by
+// v. It returns an error if the API response carried no result data.
+func (e *Error) DecodeResultData(v interface{}) error {
+	if len(e.rawResultData) == 0 || string(e.rawResultData) == "null" {
+		return errors.New("createsend: error has no result data")
+	}
+	return json.Unmarshal(e.rawResultData, v)
+}
+
+// newError builds the *Error for a response to req with a non-2xx status code
+// and the given body.
+func newError(req *http.Request, statusCode int, body []byte) *Error {
+	e := &Error{StatusCode: statusCode, Method: req.Method, URL: req.URL.String()}
+
+	var v struct {
+		Code       int
+		Message    string
+		ResultData json.RawMessage
+	}
+	if err := json.Unmarshal(body, &v); err == nil {
+		e.Code, e.Message, e.rawResultData = v.Code, v.Message, v.ResultData
+		if len(v.ResultData) > 0 {
+			_ = json.Unmarshal(v.ResultData, &e.ResultData)
+		}
+	}
+	if e.Message == "" {
+		e.Message = http.StatusText(statusCode)
+	}
+	return e
+}
+
+// IsNotFound reports whether err is an API error for HTTP status 404.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRateLimited reports whether err is an API error for HTTP status 429.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsUnauthorized reports whether err is an API error for HTTP status 401.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsSubscriberNotInList reports whether err is an API error reporting that a
+// subscriber is not in the requested list.
+func IsSubscriberNotInList(err error) bool {
+	return errors.Is(err, ErrSubscriberNotInList)
+}
@@ -0,0 +1,118 @@
+package createsend
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDo_errorStatusCodes(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   error
+		code   int
+		msg    string
+	}{
+		{http.StatusUnauthorized, `{"Code": 50, "Message": "Must supply a valid HTTP Basic Authorization header"}`, ErrUnauthorized, 50, "Must supply a valid HTTP Basic Authorization header"},
+		{http.StatusNotFound, `{"Code": 0, "Message": ""}`, ErrNotFound, 0, "Not Found"},
+		{http.StatusNotFound, `<html>Not Found</html>`, ErrNotFound, 0, "Not Found"},
+		{http.StatusTooManyRequests, ``, ErrRateLimited, 0, "Too Many Requests"},
+		{http.StatusBadRequest, `{"Code": 203, "Message": "Subscriber not in list"}`, ErrSubscriberNotInList, 203, "Subscriber not in list"},
+		{http.StatusInternalServerError, `{"Code": 500, "Message": "Sorry, something went wrong"}`, nil, 500, "Sorry, something went wrong"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, tt.body)
+			})
+
+			req, _ := client.NewRequest("DELETE", "foo.json", nil)
+			err := client.Do(req, nil)
+
+			var e *Error
+			if !errors.As(err, &e) {
+				t.Fatalf("Do returned error %#v, want *Error", err)
+			}
+			if e.StatusCode != tt.status || e.Code != tt.code || e.Message != tt.msg {
+				t.Errorf("Do returned error %+v, want status %d, code %d, message %q", e, tt.status, tt.code, tt.msg)
+			}
+			if e.Method != "DELETE" || e.URL != server.URL+"/foo.json" {
+				t.Errorf("Do returned error for %s %s, want DELETE %s", e.Method, e.URL, server.URL+"/foo.json")
+			}
+			for _, sentinel := range []error{ErrNotFound, ErrRateLimited, ErrUnauthorized, ErrSubscriberNotInList} {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.want; got != want {
+					t.Errorf("errors.Is(%v, %v) = %v, want %v", err, sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	wrapped := fmt.Errorf("getting subscriber: %w", &Error{StatusCode: http.StatusBadRequest, Code: CodeSubscriberNotInList})
+	if !IsSubscriberNotInList(wrapped) {
+		t.Error("IsSubscriberNotInList returned false for wrapped error")
+	}
+	if !IsNotFound(&Error{StatusCode: http.StatusNotFound}) {
+		t.Error("IsNotFound returned false")
+	}
+	if !IsRateLimited(&Error{StatusCode: http.StatusTooManyRequests}) {
+		t.Error("IsRateLimited returned false")
+	}
+	if !IsUnauthorized(&Error{StatusCode: http.StatusUnauthorized, Code: CodeExpiredOAuthToken}) {
+		t.Error("IsUnauthorized returned false")
+	}
+	if IsNotFound(errors.New("not found")) {
+		t.Error("IsNotFound returned true for an unrelated error")
+	}
+}
+
+func TestErrorDecodeResultData(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/webhooks/QWE123/test.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{
+				"ResultData": {
+					"FailureStatus": "ProtocolError",
+					"FailureResponseCode": 404
+				},
+				"Code": 610,
+				"Message": "The webhook request has failed"
+			}`)
+	})
+
+	err := client.ListTestWebhook("12CD", "QWE123")
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("ListTestWebhook returned error %#v, want *Error", err)
+	}
+
+	var data struct {
+		FailureStatus       string
+		FailureResponseCode int
+	}
+	if err := e.DecodeResultData(&data); err != nil {
+		t.Fatalf("DecodeResultData returned error: %v", err)
+	}
+	if data.FailureStatus != "ProtocolError" || data.FailureResponseCode != 404 {
+		t.Errorf("DecodeResultData decoded %+v", data)
+	}
+
+	want := map[string]interface{}{"FailureStatus": "ProtocolError", "FailureResponseCode": float64(404)}
+	if !reflect.DeepEqual(e.ResultData, want) {
+		t.Errorf("ResultData = %+v, want %+v", e.ResultData, want)
+	}
+
+	if err := (&Error{}).DecodeResultData(&data); err == nil {
+		t.Error("DecodeResultData without result data returned no error")
+	}
+}
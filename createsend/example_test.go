@@ -5,7 +5,7 @@ import (
 	"net/http"
 	"os"
 
-	"github.com/sourcegraph/createsend-go/createsend"
+	"github.com/Joule-CMA/createsend-go/createsend"
 )
 
 func Example() {
@@ -0,0 +1,312 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MaxImportBatchSize is the maximum number of subscribers the API accepts in
+// a single import.
+const MaxImportBatchSize = 1000
+
+// An ImportSource yields subscribers to import. Next returns io.EOF once all
+// subscribers were returned. For an import to be resumable, a source must
+// yield the same subscribers in the same order each time it is recreated.
+type ImportSource interface {
+	Next() (ImportSubscriber, error)
+}
+
+// SliceImportSource returns an ImportSource yielding subs.
+func SliceImportSource(subs []ImportSubscriber) ImportSource {
+	return &sliceImportSource{subs: subs}
+}
+
+type sliceImportSource struct {
+	subs []ImportSubscriber
+}
+
+func (s *sliceImportSource) Next() (ImportSubscriber, error) {
+	if len(s.subs) == 0 {
+		return ImportSubscriber{}, io.EOF
+	}
+	sub := s.subs[0]
+	s.subs = s.subs[1:]
+	return sub, nil
+}
+
+// An ImportCheckpoint persists the progress of a bulk import: the number of
+// subscribers from the start of the source that were imported. Load returns 0
+// if no progress was saved yet.
+type ImportCheckpoint interface {
+	Load(ctx context.Context) (int, error)
+	Save(ctx context.Context, imported int) error
+}
+
+// FileImportCheckpoint is an ImportCheckpoint that keeps the progress of an
+// import in a file, so that it survives a crash of the importing process.
+type FileImportCheckpoint struct {
+	Path string
+}
+
+func (f *FileImportCheckpoint) Load(ctx context.Context) (int, error) {
+	b, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0, fmt.Errorf("createsend: invalid import checkpoint %s: %v", f.Path, err)
+	}
+	return n, nil
+}
+
+// Save writes the progress to a temporary file and renames it over f.Path, so
+// that a crash never leaves a partially written checkpoint behind.
+func (f *FileImportCheckpoint) Save(ctx context.Context, imported int) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.WriteString(strconv.Itoa(imported) + "\n")
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+// BulkImportOptions configures BulkImport.
+type BulkImportOptions struct {
+	// BatchSize is the number of subscribers imported per request. It
+	// defaults to, and may not exceed, MaxImportBatchSize.
+	BatchSize int
+
+	// Concurrency is the maximum number of batches imported at the same
+	// time. It defaults to 1.
+	Concurrency int
+
+	// Checkpoint, if set, records the progress of the import, and an
+	// import is resumed from the progress it holds. As batches may
+	// complete out of order, the saved progress only covers batches that
+	// completed along with all batches before them; after a crash up to
+	// Concurrency-1 batches may therefore be imported again. Once an import
+	// completed, its checkpoint holds the number of subscribers in the
+	// source and must be removed before importing the source anew.
+	Checkpoint ImportCheckpoint
+
+	// These are passed on to ImportSubscribers for every batch.
+	Resubscribe                            bool
+	QueueSubscriptionBasedAutoResponders   bool
+	RestartSubscriptionBasedAutoresponders bool
+}
+
+// BulkImportResult aggregates the results of the batches of a bulk import.
+type BulkImportResult struct {
+	// Skipped is the number of subscribers skipped because the checkpoint
+	// showed them imported already, and Submitted the number of subscribers
+	// submitted in batches that completed.
+	Skipped   int
+	Submitted int
+	Batches   int
+
+	TotalUniqueEmailsSubmitted  int
+	TotalExistingSubscribers    int
+	TotalNewSubscribers         int
+	DuplicateEmailsInSubmission []string
+
+	// Failures lists the subscribers the API rejected, such as those with
+	// an invalid email address.
+	Failures []ImportFailure
+}
+
+// BulkImport imports all subscribers of src into a list, in batches of up to
+// MaxImportBatchSize subscribers. opt may be nil.
+//
+// Subscribers the API rejects are collected in the result's Failures and do
+// not stop the import. Any other error stops it: no more batches are started,
+// and BulkImport returns the result of the batches that completed along with
+// the first error.
+//
+// Like ImportSubscribers, BulkImport does not retry failed requests unless
+// the context passed to BulkImportContext was marked with AllowRetry. Whether
+// that is safe depends on the autoresponder options.
+func (c *APIClient) BulkImport(listID string, src ImportSource, opt *BulkImportOptions) (*BulkImportResult, error) {
+	return c.BulkImportContext(context.Background(), listID, src, opt)
+}
+
+// BulkImportContext is like BulkImport but uses ctx for the API requests and
+// for loading and saving the checkpoint.
+func (c *APIClient) BulkImportContext(ctx context.Context, listID string, src ImportSource, opt *BulkImportOptions) (*BulkImportResult, error) {
+	var o BulkImportOptions
+	if opt != nil {
+		o = *opt
+	}
+	if o.BatchSize <= 0 || o.BatchSize > MaxImportBatchSize {
+		o.BatchSize = MaxImportBatchSize
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+
+	res := &BulkImportResult{}
+	if o.Checkpoint != nil {
+		n, err := o.Checkpoint.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for ; res.Skipped < n; res.Skipped++ {
+			if _, err := src.Next(); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	b := &bulkImport{
+		res:       res,
+		ckpt:      o.Checkpoint,
+		done:      make(map[int]int),
+		watermark: res.Skipped,
+	}
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	b.cancel = cancel
+
+	sem := make(chan struct{}, o.Concurrency)
+	var wg sync.WaitGroup
+	offset := res.Skipped
+	for index := 0; ; index++ {
+		batch, readErr := ReadImportBatch(src, o.BatchSize)
+		if len(batch) > 0 {
+			select {
+			case sem <- struct{}{}:
+			case <-batchCtx.Done():
+			}
+			if batchCtx.Err() != nil {
+				break
+			}
+
+			wg.Add(1)
+			go func(index, from int, batch []ImportSubscriber) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				r, err := c.ImportSubscribersContext(batchCtx, listID, ImportSubscribers{
+					Subscribers:                            batch,
+					Resubscribe:                            o.Resubscribe,
+					QueueSubscriptionBasedAutoResponders:   o.QueueSubscriptionBasedAutoResponders,
+					RestartSubscriptionBasedAutoresponders: o.RestartSubscriptionBasedAutoresponders,
+				})
+				b.record(batchCtx, index, from, len(batch), r, err)
+			}(index, offset, batch)
+			offset += len(batch)
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			b.fail(fmt.Errorf("reading subscriber %d: %w", offset+1, readErr))
+			break
+		}
+	}
+	wg.Wait()
+
+	if b.err == nil && ctx.Err() != nil {
+		return res, ctx.Err()
+	}
+	return res, b.err
+}
+
+// ReadImportBatch reads up to n subscribers from src, for use with
+// ImportSubscribers. If n is not positive, MaxImportBatchSize is used. It
+// returns io.EOF, along with the subscribers read before, once src is
+// exhausted.
+func ReadImportBatch(src ImportSource, n int) ([]ImportSubscriber, error) {
+	if n <= 0 {
+		n = MaxImportBatchSize
+	}
+	batch := make([]ImportSubscriber, 0, n)
+	for len(batch) < n {
+		sub, err := src.Next()
+		if err != nil {
+			return batch, err
+		}
+		batch = append(batch, sub)
+	}
+	return batch, nil
+}
+
+// bulkImport tracks the state of a running BulkImport.
+type bulkImport struct {
+	ckpt   ImportCheckpoint
+	cancel context.CancelFunc
+
+	mu        sync.Mutex
+	res       *BulkImportResult
+	err       error
+	done      map[int]int // end offsets of completed batches beyond next
+	next      int         // index of the first batch not completed yet
+	watermark int         // end offset of batch next-1
+}
+
+// record merges the outcome of the batch with the given index, covering
+// subscribers from+1 to from+n of the source, and advances the checkpoint.
+func (b *bulkImport) record(ctx context.Context, index, from, n int, r *ImportResult, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var apiErr *Error
+	if err != nil && !(r != nil && errors.As(err, &apiErr) && apiErr.Code == CodeImportFailures) {
+		b.failLocked(fmt.Errorf("importing subscribers %d to %d: %w", from+1, from+n, err))
+		return
+	}
+
+	b.res.Batches++
+	b.res.Submitted += n
+	b.res.TotalUniqueEmailsSubmitted += r.TotalUniqueEmailsSubmitted
+	b.res.TotalExistingSubscribers += r.TotalExistingSubscribers
+	b.res.TotalNewSubscribers += r.TotalNewSubscribers
+	b.res.DuplicateEmailsInSubmission = append(b.res.DuplicateEmailsInSubmission, r.DuplicateEmailsInSubmission...)
+	b.res.Failures = append(b.res.Failures, r.FailureDetails...)
+
+	b.done[index] = from + n
+	advanced := false
+	for end, ok := b.done[b.next]; ok; end, ok = b.done[b.next] {
+		delete(b.done, b.next)
+		b.next++
+		b.watermark = end
+		advanced = true
+	}
+	if advanced && b.ckpt != nil && b.err == nil {
+		if err := b.ckpt.Save(ctx, b.watermark); err != nil {
+			b.failLocked(fmt.Errorf("saving import checkpoint: %w", err))
+		}
+	}
+}
+
+func (b *bulkImport) fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failLocked(err)
+}
+
+func (b *bulkImport) failLocked(err error) {
+	if b.err == nil {
+		b.err = err
+		b.cancel()
+	}
+}
@@ -0,0 +1,216 @@
+package createsend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testImportSubscribers(n int) []ImportSubscriber {
+	subs := make([]ImportSubscriber, n)
+	for i := range subs {
+		subs[i] = ImportSubscriber{EmailAddress: fmt.Sprintf("user%d@example.com", i+1)}
+	}
+	return subs
+}
+
+// handleImport registers an import handler for list 12CD that calls respond
+// with the subscribers of each request, after recording the request.
+func handleImport(t *testing.T, respond func(w http.ResponseWriter, subs []ImportSubscriber)) *[][]ImportSubscriber {
+	var mu sync.Mutex
+	var batches [][]ImportSubscriber
+	mux.HandleFunc("/subscribers/12CD/import.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var im ImportSubscribers
+		if err := json.NewDecoder(r.Body).Decode(&im); err != nil {
+			t.Errorf("Decoding request body: %v", err)
+		}
+		mu.Lock()
+		batches = append(batches, im.Subscribers)
+		mu.Unlock()
+		respond(w, im.Subscribers)
+	})
+	return &batches
+}
+
+func importOK(w http.ResponseWriter, subs []ImportSubscriber) {
+	w.WriteHeader(http.StatusCreated)
+	_, _ = fmt.Fprintf(w, `{"TotalUniqueEmailsSubmitted": %d, "TotalNewSubscribers": %d}`, len(subs), len(subs))
+}
+
+func TestBulkImport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	batches := handleImport(t, func(w http.ResponseWriter, subs []ImportSubscriber) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		importOK(w, subs)
+	})
+
+	res, err := client.BulkImport("12CD", SliceImportSource(testImportSubscribers(2500)), &BulkImportOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("BulkImport returned error: %v", err)
+	}
+
+	want := &BulkImportResult{Submitted: 2500, Batches: 3, TotalUniqueEmailsSubmitted: 2500, TotalNewSubscribers: 2500}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("BulkImport returned %+v, want %+v", res, want)
+	}
+
+	sizes := map[int]int{}
+	for _, b := range *batches {
+		sizes[len(b)]++
+	}
+	if want := map[int]int{1000: 2, 500: 1}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("Batch sizes = %v, want %v", sizes, want)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("%d batches were imported concurrently, want at most 2", maxInFlight)
+	}
+}
+
+func TestBulkImport_failures(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleImport(t, func(w http.ResponseWriter, subs []ImportSubscriber) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, `{
+			"Code": 210,
+			"Message": "Subscriber Import had some failures",
+			"ResultData": {
+				"TotalUniqueEmailsSubmitted": %d,
+				"TotalNewSubscribers": %d,
+				"DuplicateEmailsInSubmission": [],
+				"FailureDetails": [{"EmailAddress": %q, "Code": 1, "Message": "Invalid Email Address"}]
+			}
+		}`, len(subs), len(subs)-1, subs[0].EmailAddress)
+	})
+
+	res, err := client.BulkImport("12CD", SliceImportSource(testImportSubscribers(5)), &BulkImportOptions{BatchSize: 3})
+	if err != nil {
+		t.Fatalf("BulkImport returned error: %v", err)
+	}
+
+	want := &BulkImportResult{
+		Submitted:                  5,
+		Batches:                    2,
+		TotalUniqueEmailsSubmitted: 5,
+		TotalNewSubscribers:        3,
+		Failures: []ImportFailure{
+			{EmailAddress: "user1@example.com", Code: 1, Message: "Invalid Email Address"},
+			{EmailAddress: "user4@example.com", Code: 1, Message: "Invalid Email Address"},
+		},
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("BulkImport returned %+v, want %+v", res, want)
+	}
+}
+
+func TestBulkImport_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "createsend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ckpt := &FileImportCheckpoint{Path: filepath.Join(dir, "import.checkpoint")}
+
+	handleImport(t, func(w http.ResponseWriter, subs []ImportSubscriber) {
+		if subs[0].EmailAddress == "user3@example.com" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		importOK(w, subs)
+	})
+
+	res, err := client.BulkImport("12CD", SliceImportSource(testImportSubscribers(6)), &BulkImportOptions{BatchSize: 2, Checkpoint: ckpt})
+	if err == nil {
+		t.Fatal("BulkImport returned no error")
+	}
+	if want := "importing subscribers 3 to 4: Internal Server Error (http status 500)"; err.Error() != want {
+		t.Errorf("BulkImport returned error %q, want %q", err, want)
+	}
+	if res.Submitted != 2 {
+		t.Errorf("BulkImport submitted %d subscribers, want 2", res.Submitted)
+	}
+
+	n, err := ckpt.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Loading checkpoint: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Checkpoint = %d, want 2", n)
+	}
+}
+
+func TestBulkImport_resume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "createsend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ckpt := &FileImportCheckpoint{Path: filepath.Join(dir, "import.checkpoint")}
+	if err := ckpt.Save(context.Background(), 4); err != nil {
+		t.Fatal(err)
+	}
+
+	batches := handleImport(t, importOK)
+
+	res, err := client.BulkImport("12CD", SliceImportSource(testImportSubscribers(7)), &BulkImportOptions{BatchSize: 2, Checkpoint: ckpt})
+	if err != nil {
+		t.Fatalf("BulkImport returned error: %v", err)
+	}
+	if res.Skipped != 4 || res.Submitted != 3 {
+		t.Errorf("BulkImport skipped %d and submitted %d subscribers, want 4 and 3", res.Skipped, res.Submitted)
+	}
+	if len(*batches) == 0 || (*batches)[0][0].EmailAddress != "user5@example.com" {
+		t.Errorf("BulkImport did not resume at subscriber 5: %+v", *batches)
+	}
+
+	n, err := ckpt.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Loading checkpoint: %v", err)
+	}
+	if n != 7 {
+		t.Errorf("Checkpoint = %d, want 7", n)
+	}
+}
+
+func TestReadImportBatch(t *testing.T) {
+	src := SliceImportSource([]ImportSubscriber{{EmailAddress: "a@example.com"}, {EmailAddress: "b@example.com"}, {EmailAddress: "c@example.com"}})
+
+	batch, err := ReadImportBatch(src, 2)
+	if err != nil || len(batch) != 2 {
+		t.Fatalf("ReadImportBatch returned %+v, %v, want 2 subscribers", batch, err)
+	}
+	batch, err = ReadImportBatch(src, 2)
+	if err != io.EOF || len(batch) != 1 || batch[0].EmailAddress != "c@example.com" {
+		t.Errorf("ReadImportBatch returned %+v, %v, want c@example.com and io.EOF", batch, err)
+	}
+}
@@ -1,11 +1,11 @@
 package createsend
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -18,19 +18,26 @@ type List struct {
 	Name   string
 }
 
+// SubscriberGroup selects the subscribers of a list by state.
 type SubscriberGroup string
 
 const (
-	ActiveSubscribers SubscriberGroup = "active"
-
-/*
+	ActiveSubscribers       SubscriberGroup = "active"
 	UnconfirmedSubscribers  SubscriberGroup = "unconfirmed"
 	UnsubscribedSubscribers SubscriberGroup = "unsubscribed"
 	BouncedSubscribers      SubscriberGroup = "bounced"
 	DeletedSubscribers      SubscriberGroup = "deleted"
-*/
 )
 
+// Valid reports whether g is one of the subscriber groups the API supports.
+func (g SubscriberGroup) Valid() bool {
+	switch g {
+	case ActiveSubscribers, UnconfirmedSubscribers, UnsubscribedSubscribers, BouncedSubscribers, DeletedSubscribers:
+		return true
+	}
+	return false
+}
+
 // ListSubcribersOptions represents the URL parameters that may be used to
 // filter a subscriber list.
 //
@@ -42,6 +49,14 @@ type ListSubscribersOptions struct {
 	PageSize       int
 	OrderField     string
 	OrderDirection string
+
+	// IncludeTrackingPreference requests the ConsentToTrack field of each
+	// subscriber.
+	IncludeTrackingPreference bool
+
+	// Prefetch is the number of pages ListSubscribersAll fetches ahead of the
+	// caller concurrently. It is not sent to the API.
+	Prefetch int
 }
 
 type ListSubscribersResponse struct {
@@ -56,11 +71,18 @@ type ListSubscribersResponse struct {
 }
 
 // ListSubscribers lists all of the subscribers (in a given group, such as
-// ActiveSubscribers, UnconfirmedSubscribers, etc.).
+// ActiveSubscribers, UnconfirmedSubscribers, etc.). For the unsubscribed,
+// bounced and deleted groups, the Date of each subscriber is the date they
+// entered that state.
 //
 // See http://www.campaignmonitor.com/api/lists/#active_subscribers for more
 // information.
 func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *ListSubscribersOptions) (*ListSubscribersResponse, error) {
+	return c.ListSubscribersContext(context.Background(), listID, group, opt)
+}
+
+// ListSubscribersContext is like ListSubscribers but uses ctx for the API request.
+func (c *APIClient) ListSubscribersContext(ctx context.Context, listID string, group SubscriberGroup, opt *ListSubscribersOptions) (*ListSubscribersResponse, error) {
 	u := fmt.Sprintf("lists/%s/%s.json", listID, group)
 
 	if opt != nil {
@@ -80,6 +102,9 @@ func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *L
 		if opt.OrderDirection != "" {
 			v.Set("orderdirection", opt.OrderDirection)
 		}
+		if opt.IncludeTrackingPreference {
+			v.Set("includetrackingpreference", "true")
+		}
 
 		q := v.Encode()
 		if q != "" {
@@ -87,14 +112,94 @@ func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *L
 		}
 	}
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var results ListSubscribersResponse
 	err = c.Do(req, &results)
-	return &results, err
+	if err != nil {
+		return &results, err
+	}
+
+	for _, sub := range results.Results {
+		if err := sub.parseDates(); err != nil {
+			return nil, err
+		}
+	}
+	return &results, nil
+}
+
+// SubscriberIterator iterates over the subscribers of a list, fetching pages
+// from the API as needed. Call Next to advance it, and Err once Next returns
+// false. If the iteration is abandoned before Next returns false, Close must
+// be called to release its resources.
+type SubscriberIterator struct {
+	p       *pager
+	results []*Subscriber
+	cur     *Subscriber
+}
+
+// Next advances the iterator to the next subscriber, fetching the next page if
+// needed. It returns false when there are no more subscribers or an error
+// occurred.
+func (it *SubscriberIterator) Next() bool {
+	for len(it.results) == 0 {
+		page, ok := it.p.nextPage()
+		if !ok {
+			it.cur = nil
+			return false
+		}
+		it.results = page.(*ListSubscribersResponse).Results
+	}
+	it.cur, it.results = it.results[0], it.results[1:]
+	return true
+}
+
+// Subscriber returns the current subscriber.
+func (it *SubscriberIterator) Subscriber() *Subscriber {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any. Subscribers
+// returned before the error remain valid.
+func (it *SubscriberIterator) Err() error {
+	return it.p.err
+}
+
+// Close stops the iteration, canceling any page requests still in flight.
+func (it *SubscriberIterator) Close() {
+	it.p.close()
+}
+
+// ListSubscribersAll returns an iterator over all of the subscribers in a
+// given group, starting at opt.Page and walking all subsequent pages. opt may
+// be nil.
+//
+// See http://www.campaignmonitor.com/api/lists/#active_subscribers for more
+// information.
+func (c *APIClient) ListSubscribersAll(listID string, group SubscriberGroup, opt *ListSubscribersOptions) *SubscriberIterator {
+	return c.ListSubscribersAllContext(context.Background(), listID, group, opt)
+}
+
+// ListSubscribersAllContext is like ListSubscribersAll but uses ctx for the API
+// requests.
+func (c *APIClient) ListSubscribersAllContext(ctx context.Context, listID string, group SubscriberGroup, opt *ListSubscribersOptions) *SubscriberIterator {
+	var o ListSubscribersOptions
+	if opt != nil {
+		o = *opt
+	}
+	fetch := func(ctx context.Context, page int) (interface{}, int, error) {
+		o := o
+		o.Page = page
+		results, err := c.ListSubscribersContext(ctx, listID, group, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return results, results.NumberOfPages, nil
+	}
+	return &SubscriberIterator{p: newPager(ctx, o.Page, o.Prefetch, fetch)}
 }
 
 // ListDelete deletes a given list
@@ -102,9 +207,14 @@ func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *L
 // See https://www.campaignmonitor.com/api/lists/#deleting_a_list for more
 // information.
 func (c *APIClient) ListDelete(listID string) error {
+	return c.ListDeleteContext(context.Background(), listID)
+}
+
+// ListDeleteContext is like ListDelete but uses ctx for the API request.
+func (c *APIClient) ListDeleteContext(ctx context.Context, listID string) error {
 	u := fmt.Sprintf("lists/%s.json", listID)
 
-	req, err := c.NewRequest("DELETE", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
@@ -138,13 +248,18 @@ type ListCreateOptions struct {
 // See https://www.campaignmonitor.com/api/lists/#creating_a_list for more
 // information.
 func (c *APIClient) ListCreate(clientID string, opt *ListCreateOptions) (string, error) {
+	return c.ListCreateContext(context.Background(), clientID, opt)
+}
+
+// ListCreateContext is like ListCreate but uses ctx for the API request.
+func (c *APIClient) ListCreateContext(ctx context.Context, clientID string, opt *ListCreateOptions) (string, error) {
 	if opt.UnsubscribeSetting == "" {
 		return "", errors.New("unsubscribesetting not set")
 	}
 
 	u := fmt.Sprintf("lists/%s.json", clientID)
 
-	req, err := c.NewRequest("POST", u, opt)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, opt)
 	if err != nil {
 		return "", err
 	}
@@ -163,6 +278,148 @@ func (c *APIClient) ListCreate(clientID string, opt *ListCreateOptions) (string,
 	return s, nil
 }
 
+// ListDetails represents the settings of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_details for more
+// information.
+type ListDetails struct {
+	ListID                  string             `json:"ListID"`
+	Title                   string             `json:"Title"`
+	UnsubscribePage         string             `json:"UnsubscribePage"`
+	UnsubscribeSetting      UnsubscribeSetting `json:"UnsubscribeSetting"`
+	ConfirmedOptIn          bool               `json:"ConfirmedOptIn"`
+	ConfirmationSuccessPage string             `json:"ConfirmationSuccessPage"`
+}
+
+// ListDetails returns the settings of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_details for more
+// information.
+func (c *APIClient) ListDetails(listID string) (*ListDetails, error) {
+	return c.ListDetailsContext(context.Background(), listID)
+}
+
+// ListDetailsContext is like ListDetails but uses ctx for the API request.
+func (c *APIClient) ListDetailsContext(ctx context.Context, listID string) (*ListDetails, error) {
+	u := fmt.Sprintf("lists/%s.json", listID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details ListDetails
+	err = c.Do(req, &details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// ListUpdateOptions represents the parameters needed to update a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_list for more
+// information.
+type ListUpdateOptions struct {
+	Title                   string             `json:"Title"`
+	UnsubscribePage         string             `json:"UnsubscribePage"`
+	UnsubscribeSetting      UnsubscribeSetting `json:"UnsubscribeSetting"`
+	ConfirmedOptIn          bool               `json:"ConfirmedOptIn"`
+	ConfirmationSuccessPage string             `json:"ConfirmationSuccessPage"`
+
+	// AddUnsubscribesToSuppList adds the list's existing unsubscribes to
+	// the client's suppression list when UnsubscribeSetting is changed to
+	// AllClientLists.
+	AddUnsubscribesToSuppList bool `json:"AddUnsubscribesToSuppList"`
+
+	// ScrubActiveWithSuppList unsubscribes active subscribers that are on
+	// the client's suppression list when UnsubscribeSetting is changed to
+	// AllClientLists.
+	ScrubActiveWithSuppList bool `json:"ScrubActiveWithSuppList"`
+}
+
+// ListUpdate updates the settings of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_list for more
+// information.
+func (c *APIClient) ListUpdate(listID string, opt *ListUpdateOptions) error {
+	return c.ListUpdateContext(context.Background(), listID, opt)
+}
+
+// ListUpdateContext is like ListUpdate but uses ctx for the API request.
+func (c *APIClient) ListUpdateContext(ctx context.Context, listID string, opt *ListUpdateOptions) error {
+	if opt.UnsubscribeSetting == "" {
+		return errors.New("unsubscribesetting not set")
+	}
+
+	u := fmt.Sprintf("lists/%s.json", listID)
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, opt)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// ListStats represents the subscriber statistics of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_stats for more
+// information.
+type ListStats struct {
+	TotalActiveSubscribers        int `json:"TotalActiveSubscribers"`
+	NewActiveSubscribersToday     int `json:"NewActiveSubscribersToday"`
+	NewActiveSubscribersYesterday int `json:"NewActiveSubscribersYesterday"`
+	NewActiveSubscribersThisWeek  int `json:"NewActiveSubscribersThisWeek"`
+	NewActiveSubscribersThisMonth int `json:"NewActiveSubscribersThisMonth"`
+	NewActiveSubscribersThisYear  int `json:"NewActiveSubscribersThisYear"`
+	TotalUnsubscribes             int `json:"TotalUnsubscribes"`
+	UnsubscribesToday             int `json:"UnsubscribesToday"`
+	UnsubscribesYesterday         int `json:"UnsubscribesYesterday"`
+	UnsubscribesThisWeek          int `json:"UnsubscribesThisWeek"`
+	UnsubscribesThisMonth         int `json:"UnsubscribesThisMonth"`
+	UnsubscribesThisYear          int `json:"UnsubscribesThisYear"`
+	TotalDeleted                  int `json:"TotalDeleted"`
+	DeletedToday                  int `json:"DeletedToday"`
+	DeletedYesterday              int `json:"DeletedYesterday"`
+	DeletedThisWeek               int `json:"DeletedThisWeek"`
+	DeletedThisMonth              int `json:"DeletedThisMonth"`
+	DeletedThisYear               int `json:"DeletedThisYear"`
+	TotalBounces                  int `json:"TotalBounces"`
+	BouncesToday                  int `json:"BouncesToday"`
+	BouncesYesterday              int `json:"BouncesYesterday"`
+	BouncesThisWeek               int `json:"BouncesThisWeek"`
+	BouncesThisMonth              int `json:"BouncesThisMonth"`
+	BouncesThisYear               int `json:"BouncesThisYear"`
+}
+
+// ListStats returns the subscriber statistics of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_stats for more
+// information.
+func (c *APIClient) ListStats(listID string) (*ListStats, error) {
+	return c.ListStatsContext(context.Background(), listID)
+}
+
+// ListStatsContext is like ListStats but uses ctx for the API request.
+func (c *APIClient) ListStatsContext(ctx context.Context, listID string) (*ListStats, error) {
+	u := fmt.Sprintf("lists/%s/stats.json", listID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats ListStats
+	err = c.Do(req, &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
 type DataType string
 
 //noinspection ALL
@@ -189,9 +446,14 @@ type CustomFieldDefinition struct {
 // See https://www.campaignmonitor.com/api/lists/#list_custom_fields for
 // more information.
 func (c *APIClient) ListCustomFields(listID string) ([]CustomFieldDefinition, error) {
+	return c.ListCustomFieldsContext(context.Background(), listID)
+}
+
+// ListCustomFieldsContext is like ListCustomFields but uses ctx for the API request.
+func (c *APIClient) ListCustomFieldsContext(ctx context.Context, listID string) ([]CustomFieldDefinition, error) {
 	u := fmt.Sprintf("lists/%s/customfields.json", listID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -217,32 +479,95 @@ type CustomFieldCreate struct {
 // See https://www.campaignmonitor.com/api/lists/#creating_a_custom_field for
 // more information.
 func (c *APIClient) ListCreateCustomField(listID string, def *CustomFieldCreate) (string, error) {
+	return c.ListCreateCustomFieldContext(context.Background(), listID, def)
+}
+
+// ListCreateCustomFieldContext is like ListCreateCustomField but uses ctx for the API request.
+func (c *APIClient) ListCreateCustomFieldContext(ctx context.Context, listID string, def *CustomFieldCreate) (string, error) {
 	u := fmt.Sprintf("lists/%s/customfields.json", listID)
 
-	req, err := c.NewRequest("POST", u, def)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, def)
 	if err != nil {
 		return "", err
 	}
 
-	var v interface{}
+	var key string
+	err = c.Do(req, &key)
+	if err != nil {
+		return "", err
+	}
 
-	r, ok := v.(string)
-	if !ok {
-		return "", errors.New("return is not a string")
+	return key, nil
+}
+
+// CustomFieldUpdate represents the parameters needed to rename a custom field
+// or change its visibility.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_custom_field for
+// more information.
+type CustomFieldUpdate struct {
+	FieldName                 string `json:"FieldName"`
+	VisibleInPreferenceCenter bool   `json:"VisibleInPreferenceCenter"`
+}
+
+// ListUpdateCustomField updates a CustomField on the given list and returns
+// its key, which changes when the field is renamed.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_custom_field for
+// more information.
+func (c *APIClient) ListUpdateCustomField(listID string, cfKey string, upd *CustomFieldUpdate) (string, error) {
+	return c.ListUpdateCustomFieldContext(context.Background(), listID, cfKey, upd)
+}
+
+// ListUpdateCustomFieldContext is like ListUpdateCustomField but uses ctx for the API request.
+func (c *APIClient) ListUpdateCustomFieldContext(ctx context.Context, listID string, cfKey string, upd *CustomFieldUpdate) (string, error) {
+	u := fmt.Sprintf("lists/%s/customfields/%s.json", listID, url.PathEscape(cfKey))
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, upd)
+	if err != nil {
+		return "", err
 	}
 
-	err = c.Do(req, &v)
+	var key string
+	err = c.Do(req, &key)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return r, nil
-		} else {
-			return r, err
-		}
+		return "", err
 	}
 
-	return r, nil
+	return key, nil
+}
+
+// CustomFieldOptionsUpdate represents the parameters needed to change the
+// options of a MultiSelectOne or MultiSelectMany custom field.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_custom_field_options
+// for more information.
+type CustomFieldOptionsUpdate struct {
+	// KeepExistingOptions adds Options to the existing options instead of
+	// replacing them.
+	KeepExistingOptions bool     `json:"KeepExistingOptions"`
+	Options             []string `json:"Options"`
+}
+
+// ListUpdateCustomFieldOptions updates the options of a multi-select
+// CustomField on the given list.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_custom_field_options
+// for more information.
+func (c *APIClient) ListUpdateCustomFieldOptions(listID string, cfKey string, upd *CustomFieldOptionsUpdate) error {
+	return c.ListUpdateCustomFieldOptionsContext(context.Background(), listID, cfKey, upd)
+}
+
+// ListUpdateCustomFieldOptionsContext is like ListUpdateCustomFieldOptions but uses ctx for the API request.
+func (c *APIClient) ListUpdateCustomFieldOptionsContext(ctx context.Context, listID string, cfKey string, upd *CustomFieldOptionsUpdate) error {
+	u := fmt.Sprintf("lists/%s/customfields/%s/options.json", listID, url.PathEscape(cfKey))
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, upd)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
 }
 
 // ListDeleteCustomField deletes a CustomField from a given list.
@@ -250,22 +575,21 @@ func (c *APIClient) ListCreateCustomField(listID string, def *CustomFieldCreate)
 // See https://www.campaignmonitor.com/api/lists/#deleting_a_custom_field for
 // more information.
 func (c *APIClient) ListDeleteCustomField(listID string, cfKey string) error {
-	u := fmt.Sprintf("lists/%s/customfields/%s.json", listID, cfKey)
+	return c.ListDeleteCustomFieldContext(context.Background(), listID, cfKey)
+}
+
+// ListDeleteCustomFieldContext is like ListDeleteCustomField but uses ctx for the API request.
+func (c *APIClient) ListDeleteCustomFieldContext(ctx context.Context, listID string, cfKey string) error {
+	u := fmt.Sprintf("lists/%s/customfields/%s.json", listID, url.PathEscape(cfKey))
 
-	req, err := c.NewRequest("DELETE", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return err
@@ -282,9 +606,14 @@ type ListSegment struct {
 // See https://www.campaignmonitor.com/api/lists/#list_segments for
 // more information.
 func (c *APIClient) ListSegments(listID string) ([]ListSegment, error) {
+	return c.ListSegmentsContext(context.Background(), listID)
+}
+
+// ListSegmentsContext is like ListSegments but uses ctx for the API request.
+func (c *APIClient) ListSegmentsContext(ctx context.Context, listID string) ([]ListSegment, error) {
 	u := fmt.Sprintf("lists/%s/segments.json", listID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -292,13 +621,7 @@ func (c *APIClient) ListSegments(listID string) ([]ListSegment, error) {
 	var result []ListSegment
 	err = c.Do(req, &result)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return result, nil
-		} else {
-			return result, err
-		}
+		return nil, err
 	}
 
 	return result, nil
@@ -321,9 +644,14 @@ type Webhook struct {
 // See https://www.campaignmonitor.com/api/lists/#list_webhooks for
 // more information.
 func (c *APIClient) ListWebhooks(listID string) ([]Webhook, error) {
+	return c.ListWebhooksContext(context.Background(), listID)
+}
+
+// ListWebhooksContext is like ListWebhooks but uses ctx for the API request.
+func (c *APIClient) ListWebhooksContext(ctx context.Context, listID string) ([]Webhook, error) {
 	u := fmt.Sprintf("lists/%s/webhooks.json", listID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -331,13 +659,7 @@ func (c *APIClient) ListWebhooks(listID string) ([]Webhook, error) {
 	var result []Webhook
 	err = c.Do(req, &result)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return result, nil
-		} else {
-			return result, err
-		}
+		return nil, err
 	}
 
 	return result, nil
@@ -348,9 +670,14 @@ func (c *APIClient) ListWebhooks(listID string) ([]Webhook, error) {
 // See https://www.campaignmonitor.com/api/lists/#list_webhooks for
 // more information.
 func (c *APIClient) ListCreateWebhook(listID string, webhook *WebhookCreate) (string, error) {
+	return c.ListCreateWebhookContext(context.Background(), listID, webhook)
+}
+
+// ListCreateWebhookContext is like ListCreateWebhook but uses ctx for the API request.
+func (c *APIClient) ListCreateWebhookContext(ctx context.Context, listID string, webhook *WebhookCreate) (string, error) {
 	u := fmt.Sprintf("lists/%s/webhooks.json", listID)
 
-	req, err := c.NewRequest("POST", u, webhook)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, webhook)
 	if err != nil {
 		return "", err
 	}
@@ -358,13 +685,7 @@ func (c *APIClient) ListCreateWebhook(listID string, webhook *WebhookCreate) (st
 	var result string
 	err = c.Do(req, &result)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return result, nil
-		} else {
-			return result, err
-		}
+		return "", err
 	}
 
 	return result, nil
@@ -375,9 +696,14 @@ func (c *APIClient) ListCreateWebhook(listID string, webhook *WebhookCreate) (st
 // See https://www.campaignmonitor.com/api/lists/#testing_a_webhook for
 // more information.
 func (c *APIClient) ListTestWebhook(listID string, webhookID string) error {
+	return c.ListTestWebhookContext(context.Background(), listID, webhookID)
+}
+
+// ListTestWebhookContext is like ListTestWebhook but uses ctx for the API request.
+func (c *APIClient) ListTestWebhookContext(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s/test.json", listID, webhookID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return err
 	}
@@ -385,13 +711,7 @@ func (c *APIClient) ListTestWebhook(listID string, webhookID string) error {
 	err = c.Do(req, nil)
 
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
@@ -402,22 +722,21 @@ func (c *APIClient) ListTestWebhook(listID string, webhookID string) error {
 // See https://www.campaignmonitor.com/api/lists/#deleting_a_webhook for
 // more information.
 func (c *APIClient) ListDeleteWebhook(listID string, webhookID string) error {
+	return c.ListDeleteWebhookContext(context.Background(), listID, webhookID)
+}
+
+// ListDeleteWebhookContext is like ListDeleteWebhook but uses ctx for the API request.
+func (c *APIClient) ListDeleteWebhookContext(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s.json", listID, webhookID)
 
-	req, err := c.NewRequest("DELETE", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
@@ -428,22 +747,21 @@ func (c *APIClient) ListDeleteWebhook(listID string, webhookID string) error {
 // See https://www.campaignmonitor.com/api/lists/#activating_a_webhook for
 // more information.
 func (c *APIClient) ListActivateWebhook(listID string, webhookID string) error {
+	return c.ListActivateWebhookContext(context.Background(), listID, webhookID)
+}
+
+// ListActivateWebhookContext is like ListActivateWebhook but uses ctx for the API request.
+func (c *APIClient) ListActivateWebhookContext(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s/activate.json", listID, webhookID)
 
-	req, err := c.NewRequest("PUT", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
@@ -454,22 +772,21 @@ func (c *APIClient) ListActivateWebhook(listID string, webhookID string) error {
 // See https://www.campaignmonitor.com/api/lists/#deactivating_a_webhook for
 // more information.
 func (c *APIClient) ListDeactivateWebhook(listID string, webhookID string) error {
+	return c.ListDeactivateWebhookContext(context.Background(), listID, webhookID)
+}
+
+// ListDeactivateWebhookContext is like ListDeactivateWebhook but uses ctx for the API request.
+func (c *APIClient) ListDeactivateWebhookContext(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s/deactivate.json", listID, webhookID)
 
-	req, err := c.NewRequest("PUT", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package createsend
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -186,6 +187,69 @@ func TestListCreate(t *testing.T) {
 	}
 }
 
+func TestListSubscribersAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/active.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("pagesize") != "2" {
+			t.Errorf("Expected pagesize to equal 2 but was %s", r.FormValue("pagesize"))
+		}
+		switch r.FormValue("page") {
+		case "1":
+			_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "alice@example.com"}, {"EmailAddress": "bob@example.com"}], "PageNumber": 1, "NumberOfPages": 2}`)
+		case "2":
+			_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "carol@example.com"}], "PageNumber": 2, "NumberOfPages": 2}`)
+		default:
+			t.Errorf("Unexpected page %s", r.FormValue("page"))
+		}
+	})
+
+	it := client.ListSubscribersAll("12CD", ActiveSubscribers, &ListSubscribersOptions{PageSize: 2, Prefetch: 1})
+	defer it.Close()
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Subscriber().EmailAddress)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("ListSubscribersAll returned error: %v", err)
+	}
+
+	want := []string{"alice@example.com", "bob@example.com", "carol@example.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListSubscribersAll returned %v, want %v", got, want)
+	}
+}
+
+func TestListSubscribersAllFail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/active.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"Results": [{"EmailAddress": "alice@example.com"}], "PageNumber": 1, "NumberOfPages": 3}`)
+	})
+
+	it := client.ListSubscribersAll("12CD", ActiveSubscribers, nil)
+	defer it.Close()
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Subscriber().EmailAddress)
+	}
+	if it.Err() == nil {
+		t.Error("ListSubscribersAll returned no error")
+	}
+	if want := []string{"alice@example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListSubscribersAll returned %v, want %v", got, want)
+	}
+}
+
 func TestListDelete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -265,7 +329,12 @@ func TestListCreateCustomField(t *testing.T) {
 	mux.HandleFunc("/lists/12CD/customfields.json", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
 		w.WriteHeader(http.StatusOK)
-		_, _ = fmt.Fprint(w, `"AE12"`)
+		var got CustomFieldCreate
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got.FieldName != "test" || got.DataType != Text {
+			t.Errorf("Request body = %+v", got)
+		}
+		_, _ = fmt.Fprint(w, `"[test]"`)
 	})
 
 	id, err := client.ListCreateCustomField("12CD", &CustomFieldCreate{FieldName: "test", DataType: Text, VisibleInPreferenceCenter: false})
@@ -273,7 +342,7 @@ func TestListCreateCustomField(t *testing.T) {
 		t.Errorf("ListCreateCustomField return error: %v", err)
 	}
 
-	if id != "AE12" {
+	if id != "[test]" {
 		t.Errorf("Id returned is wrong: %v", id)
 	}
 }
@@ -497,3 +566,240 @@ func TestListDeactivateWebhook(t *testing.T) {
 		t.Errorf("ListDeactivateWebhook returned an error: %v", err)
 	}
 }
+
+func TestListSubscribers_bounced(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/bounced.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("includetrackingpreference"); got != "true" {
+			t.Errorf("includetrackingpreference = %q, want %q", got, "true")
+		}
+		_, _ = fmt.Fprint(w, `{"Results": [{
+			"EmailAddress": "bob@example.com",
+			"Name": "bob",
+			"Date": "2010-10-26 09:15:00",
+			"ListJoinedDate": "2010-10-25 10:28:00",
+			"State": "Bounced",
+			"ConsentToTrack": "Yes"
+		}], "NumberOfPages": 1}`)
+	})
+
+	subs, err := client.ListSubscribers("12CD", BouncedSubscribers, &ListSubscribersOptions{IncludeTrackingPreference: true})
+	if err != nil {
+		t.Fatalf("ListSubscribers returned error: %v", err)
+	}
+
+	want := &Subscriber{
+		EmailAddress:      "bob@example.com",
+		Name:              "bob",
+		Date:              time.Date(2010, 10, 26, 9, 15, 0, 0, time.UTC),
+		DateStr:           "2010-10-26T09:15:00Z",
+		ListJoinedDate:    time.Date(2010, 10, 25, 10, 28, 0, 0, time.UTC),
+		ListJoinedDateStr: "2010-10-25T10:28:00Z",
+		State:             "Bounced",
+		ConsentToTrack:    ConsentYes,
+	}
+	if len(subs.Results) != 1 || !reflect.DeepEqual(subs.Results[0], want) {
+		t.Errorf("ListSubscribers returned %+v, want %+v", subs.Results, want)
+	}
+}
+
+func TestSubscriberGroupValid(t *testing.T) {
+	for _, g := range []SubscriberGroup{ActiveSubscribers, UnconfirmedSubscribers, UnsubscribedSubscribers, BouncedSubscribers, DeletedSubscribers} {
+		if !g.Valid() {
+			t.Errorf("%q.Valid() = false, want true", g)
+		}
+	}
+	for _, g := range []SubscriberGroup{"", "Active", "suppressed"} {
+		if g.Valid() {
+			t.Errorf("%q.Valid() = true, want false", g)
+		}
+	}
+}
+
+func TestListDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"ConfirmedOptIn": false,
+			"Title": "a non-basic list :)",
+			"UnsubscribePage": "",
+			"UnsubscribeSetting": "AllClientLists",
+			"ListID": "12CD",
+			"ConfirmationSuccessPage": ""
+		}`)
+	})
+
+	details, err := client.ListDetails("12CD")
+	if err != nil {
+		t.Errorf("ListDetails returned error: %v", err)
+	}
+
+	want := &ListDetails{ListID: "12CD", Title: "a non-basic list :)", UnsubscribeSetting: AllClientLists}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("ListDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestListUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	opt := &ListUpdateOptions{
+		Title:                     "Newsletter",
+		UnsubscribeSetting:        AllClientLists,
+		ConfirmedOptIn:            true,
+		ConfirmationSuccessPage:   "http://example.com/confirmed",
+		AddUnsubscribesToSuppList: true,
+		ScrubActiveWithSuppList:   true,
+	}
+	mux.HandleFunc("/lists/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got ListUpdateOptions
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got != *opt {
+			t.Errorf("Request body = %+v, want %+v", got, *opt)
+		}
+	})
+
+	err := client.ListUpdate("12CD", opt)
+	if err != nil {
+		t.Errorf("ListUpdate returned error: %v", err)
+	}
+}
+
+func TestListUpdate_noUnsubscribeSetting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	err := client.ListUpdate("12CD", &ListUpdateOptions{Title: "Newsletter"})
+	if err == nil {
+		t.Error("ListUpdate returned no error")
+	}
+}
+
+func TestListStats(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/stats.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"TotalActiveSubscribers": 6,
+			"NewActiveSubscribersToday": 1,
+			"NewActiveSubscribersYesterday": 2,
+			"NewActiveSubscribersThisWeek": 3,
+			"NewActiveSubscribersThisMonth": 4,
+			"NewActiveSubscribersThisYear": 5,
+			"TotalUnsubscribes": 2,
+			"UnsubscribesToday": 0,
+			"UnsubscribesYesterday": 0,
+			"UnsubscribesThisWeek": 1,
+			"UnsubscribesThisMonth": 1,
+			"UnsubscribesThisYear": 2,
+			"TotalDeleted": 1,
+			"DeletedToday": 0,
+			"DeletedYesterday": 0,
+			"DeletedThisWeek": 0,
+			"DeletedThisMonth": 1,
+			"DeletedThisYear": 1,
+			"TotalBounces": 3,
+			"BouncesToday": 1,
+			"BouncesYesterday": 0,
+			"BouncesThisWeek": 1,
+			"BouncesThisMonth": 2,
+			"BouncesThisYear": 3
+		}`)
+	})
+
+	stats, err := client.ListStats("12CD")
+	if err != nil {
+		t.Errorf("ListStats returned error: %v", err)
+	}
+
+	want := &ListStats{
+		TotalActiveSubscribers:        6,
+		NewActiveSubscribersToday:     1,
+		NewActiveSubscribersYesterday: 2,
+		NewActiveSubscribersThisWeek:  3,
+		NewActiveSubscribersThisMonth: 4,
+		NewActiveSubscribersThisYear:  5,
+		TotalUnsubscribes:             2,
+		UnsubscribesThisWeek:          1,
+		UnsubscribesThisMonth:         1,
+		UnsubscribesThisYear:          2,
+		TotalDeleted:                  1,
+		DeletedThisMonth:              1,
+		DeletedThisYear:               1,
+		TotalBounces:                  3,
+		BouncesToday:                  1,
+		BouncesThisWeek:               1,
+		BouncesThisMonth:              2,
+		BouncesThisYear:               3,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("ListStats returned %+v, want %+v", stats, want)
+	}
+}
+
+func TestListUpdateCustomField(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/lists/12CD/customfields/[Colour].json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got CustomFieldUpdate
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if want := (CustomFieldUpdate{FieldName: "Favourite colour", VisibleInPreferenceCenter: true}); got != want {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+		_, _ = fmt.Fprint(w, `"[Favouritecolour]"`)
+	})
+
+	key, err := client.ListUpdateCustomField("12CD", "[Colour]", &CustomFieldUpdate{FieldName: "Favourite colour", VisibleInPreferenceCenter: true})
+	if err != nil {
+		t.Errorf("ListUpdateCustomField returned error: %v", err)
+	}
+	if key != "[Favouritecolour]" {
+		t.Errorf("ListUpdateCustomField returned key %q, want %q", key, "[Favouritecolour]")
+	}
+}
+
+func TestListUpdateCustomFieldOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/lists/12CD/customfields/[Colour]/options.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got CustomFieldOptionsUpdate
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		want := CustomFieldOptionsUpdate{KeepExistingOptions: true, Options: []string{"Red", "Green"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+	})
+
+	err := client.ListUpdateCustomFieldOptions("12CD", "[Colour]", &CustomFieldOptionsUpdate{KeepExistingOptions: true, Options: []string{"Red", "Green"}})
+	if err != nil {
+		t.Errorf("ListUpdateCustomFieldOptions returned error: %v", err)
+	}
+}
+
+func TestListDeleteCustomField_escapesKey(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/lists/12CD/customfields/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if want := "/lists/12CD/customfields/a%2Fb%3F.json"; r.URL.EscapedPath() != want {
+			t.Errorf("Request path = %q, want %q", r.URL.EscapedPath(), want)
+		}
+	})
+
+	err := client.ListDeleteCustomField("12CD", "a/b?")
+	if err != nil {
+		t.Errorf("ListDeleteCustomField returned error: %v", err)
+	}
+}
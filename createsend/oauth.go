@@ -0,0 +1,370 @@
+package createsend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAuthURL  = "https://api.createsend.com/oauth"
+	defaultTokenURL = "https://api.createsend.com/oauth/token"
+
+	// expiryDelta is how long before its expiry a token is refreshed, so
+	// that it does not expire while a request is in flight.
+	expiryDelta = 30 * time.Second
+)
+
+// OAuth scopes that an application may request access to.
+//
+// See https://www.campaignmonitor.com/api/getting-started/#authenticating-with-oauth
+// for more information.
+const (
+	ScopeViewReports              = "ViewReports"
+	ScopeManageLists              = "ManageLists"
+	ScopeCreateCampaigns          = "CreateCampaigns"
+	ScopeImportSubscribers        = "ImportSubscribers"
+	ScopeSendCampaigns            = "SendCampaigns"
+	ScopeViewSubscribersInReports = "ViewSubscribersInReports"
+	ScopeManageTemplates          = "ManageTemplates"
+	ScopeAdministerPeople         = "AdministerPeople"
+	ScopeAdministerAccount        = "AdministerAccount"
+	ScopeViewTransactional        = "ViewTransactional"
+	ScopeSendTransactional        = "SendTransactional"
+)
+
+// OAuthConfig describes a Campaign Monitor OAuth application and the way its
+// users authorize it.
+//
+// See https://www.campaignmonitor.com/api/getting-started/#authenticating-with-oauth
+// for more information.
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []string
+
+	// AuthURL and TokenURL override the Campaign Monitor OAuth endpoints.
+	AuthURL  string
+	TokenURL string
+
+	// HTTPClient is used to talk to the token endpoint. If nil,
+	// http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// Token is an OAuth access token together with the refresh token used to
+// renew it.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+
+	// Expiry is when the access token expires. It is zero if the expiry is
+	// unknown, such as for a token obtained without an "expires_in".
+	Expiry time.Time
+}
+
+// Valid reports whether t holds an access token that is not known to have
+// expired. A token with an unknown expiry is valid until the API rejects it,
+// after which OAuthTransport renews it using a TokenRenewer.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(expiryDelta).Before(t.Expiry))
+}
+
+// OAuthError is an error returned by the OAuth token endpoint.
+type OAuthError struct {
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("createsend: oauth error %s (http status %d)", e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("createsend: oauth error %s: %s", e.Code, e.Description)
+}
+
+// AuthorizeURL returns the URL to send a user to in order to authorize the
+// application. state is passed back unchanged to the redirect URI and should
+// be used to protect against cross-site request forgery.
+func (c *OAuthConfig) AuthorizeURL(state string) string {
+	v := url.Values{}
+	v.Set("type", "web_server")
+	v.Set("client_id", c.ClientID)
+	v.Set("redirect_uri", c.RedirectURI)
+	v.Set("scope", strings.Join(c.Scopes, ","))
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	u := c.AuthURL
+	if u == "" {
+		u = defaultAuthURL
+	}
+	return u + "?" + v.Encode()
+}
+
+// Exchange exchanges the code passed to the redirect URI for a token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+	v.Set("redirect_uri", c.RedirectURI)
+	v.Set("code", code)
+	return c.requestToken(ctx, v)
+}
+
+// Refresh obtains a new token using a refresh token.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "refresh_token")
+	v.Set("refresh_token", refreshToken)
+	return c.requestToken(ctx, v)
+}
+
+func (c *OAuthConfig) requestToken(ctx context.Context, v url.Values) (*Token, error) {
+	u := c.TokenURL
+	if u == "" {
+		u = defaultTokenURL
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", u, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", userAgent)
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		e := &OAuthError{StatusCode: resp.StatusCode}
+		if json.Unmarshal(body, e) != nil || e.Code == "" {
+			e.Code = http.StatusText(resp.StatusCode)
+		}
+		return nil, e
+	}
+
+	var tr struct {
+		AccessToken  string `json:"access_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return nil, err
+	}
+	if tr.AccessToken == "" {
+		return nil, &OAuthError{StatusCode: resp.StatusCode, Code: "invalid_response", Description: "no access token in response"}
+	}
+
+	t := &Token{AccessToken: tr.AccessToken, RefreshToken: tr.RefreshToken}
+	if tr.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return t, nil
+}
+
+// A TokenSource returns a valid token, refreshing it if necessary.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// A TokenRenewer is a TokenSource that can renew a token the API rejected as
+// expired, such as a token that was revoked or whose expiry was unknown.
+// Renew returns a new token, unless the source already replaced rejected with
+// another one, which it then returns.
+type TokenRenewer interface {
+	TokenSource
+	Renew(ctx context.Context, rejected *Token) (*Token, error)
+}
+
+// A TokenStore persists the token of a single Campaign Monitor account, such
+// as one of an integration's customers. Load returns a nil token if none has
+// been saved yet.
+type TokenStore interface {
+	Load(ctx context.Context) (*Token, error)
+	Save(ctx context.Context, t *Token) error
+}
+
+// MemoryTokenStore is a TokenStore that keeps the token in memory.
+type MemoryTokenStore struct {
+	mu sync.Mutex
+	t  *Token
+}
+
+// NewMemoryTokenStore returns a MemoryTokenStore holding t.
+func NewMemoryTokenStore(t *Token) *MemoryTokenStore {
+	return &MemoryTokenStore{t: t}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.t, nil
+}
+
+func (s *MemoryTokenStore) Save(ctx context.Context, t *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.t = t
+	return nil
+}
+
+// TokenSource returns a TokenSource that loads the token from store, refreshes
+// it when it has expired and saves the refreshed token back to store. The
+// returned TokenSource is also a TokenRenewer.
+func (c *OAuthConfig) TokenSource(store TokenStore) TokenSource {
+	return &refreshingTokenSource{config: c, store: store}
+}
+
+type refreshingTokenSource struct {
+	config *OAuthConfig
+	store  TokenStore
+
+	mu sync.Mutex // serializes refreshes
+}
+
+func (s *refreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t.Valid() {
+		return t, nil
+	}
+	return s.refresh(ctx, t)
+}
+
+func (s *refreshingTokenSource) Renew(ctx context.Context, rejected *Token) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t.Valid() && (rejected == nil || t.AccessToken != rejected.AccessToken) {
+		return t, nil // renewed by a concurrent request
+	}
+	return s.refresh(ctx, t)
+}
+
+// refresh replaces t with a new token and saves it. s.mu must be held.
+func (s *refreshingTokenSource) refresh(ctx context.Context, t *Token) (*Token, error) {
+	if t == nil || t.RefreshToken == "" {
+		return nil, &OAuthError{Code: "invalid_grant", Description: "no refresh token available"}
+	}
+
+	nt, err := s.config.Refresh(ctx, t.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if nt.RefreshToken == "" {
+		nt.RefreshToken = t.RefreshToken
+	}
+	if err := s.store.Save(ctx, nt); err != nil {
+		return nil, err
+	}
+	return nt, nil
+}
+
+// OAuthTransport is an http.RoundTripper that authenticates requests with an
+// OAuth access token obtained from Source.
+//
+// If the API rejects a token as expired (HTTP status 401 with error code
+// CodeExpiredOAuthToken) and Source is a TokenRenewer, the token is renewed
+// once and the request is sent again, provided its body can be replayed.
+type OAuthTransport struct {
+	Source    TokenSource
+	Transport http.RoundTripper
+}
+
+func (t *OAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	tok, err := t.Source.Token(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the request they are given.
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+
+	resp, err := transport.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	renewer, ok := t.Source.(TokenRenewer)
+	if !ok || !canReplay(req) || !isExpiredTokenResponse(resp) {
+		return resp, nil
+	}
+
+	tok, err = renewer.Renew(req.Context(), tok)
+	if err != nil {
+		return nil, err
+	}
+	r = req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+	if req.GetBody != nil {
+		if r.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return transport.RoundTrip(r)
+}
+
+// canReplay reports whether req can be sent again after a first attempt.
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// isExpiredTokenResponse reports whether resp rejects the request's OAuth
+// token as expired. It replaces the body of a 401 response with a copy it
+// has read, so that the body stays readable.
+func isExpiredTokenResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var v struct{ Code int }
+	if json.Unmarshal(body, &v) != nil || v.Code != CodeExpiredOAuthToken {
+		return false
+	}
+	return true
+}
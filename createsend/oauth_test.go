@@ -0,0 +1,224 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// tokenServer starts a stand-in for the Campaign Monitor OAuth token endpoint.
+// handle is called with the parsed form of every token request.
+func tokenServer(t *testing.T, handle func(w http.ResponseWriter, form url.Values)) (*httptest.Server, *OAuthConfig) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+			t.Errorf("Token request Content-Type = %q", ct)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm returned error: %v", err)
+		}
+		handle(w, r.PostForm)
+	}))
+	config := &OAuthConfig{
+		ClientID:     "32757",
+		ClientSecret: "s3cret",
+		RedirectURI:  "https://example.com/callback",
+		Scopes:       []string{ScopeViewReports, ScopeManageLists},
+		TokenURL:     ts.URL,
+	}
+	return ts, config
+}
+
+func TestOAuthConfigAuthorizeURL(t *testing.T) {
+	config := &OAuthConfig{
+		ClientID:    "32757",
+		RedirectURI: "https://example.com/callback",
+		Scopes:      []string{ScopeViewReports, ScopeCreateCampaigns},
+	}
+
+	got := config.AuthorizeURL("xyz")
+	want := "https://api.createsend.com/oauth?client_id=32757&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&scope=ViewReports%2CCreateCampaigns&state=xyz&type=web_server"
+	if got != want {
+		t.Errorf("AuthorizeURL returned %s, want %s", got, want)
+	}
+}
+
+func TestOAuthConfigExchange(t *testing.T) {
+	ts, config := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		want := url.Values{
+			"grant_type":    {"authorization_code"},
+			"client_id":     {"32757"},
+			"client_secret": {"s3cret"},
+			"redirect_uri":  {"https://example.com/callback"},
+			"code":          {"4dc2b5d0"},
+		}
+		if form.Encode() != want.Encode() {
+			t.Errorf("Token request form = %v, want %v", form, want)
+		}
+		_, _ = fmt.Fprint(w, `{"access_token": "SlAV32hkKG", "expires_in": 1209600, "refresh_token": "tGzv3JOkF0XG5Qx2TlKWIA"}`)
+	})
+	defer ts.Close()
+
+	tok, err := config.Exchange(context.Background(), "4dc2b5d0")
+	if err != nil {
+		t.Fatalf("Exchange returned error: %v", err)
+	}
+	if tok.AccessToken != "SlAV32hkKG" || tok.RefreshToken != "tGzv3JOkF0XG5Qx2TlKWIA" {
+		t.Errorf("Exchange returned %+v", tok)
+	}
+	if d := time.Until(tok.Expiry); d < 13*24*time.Hour || d > 14*24*time.Hour {
+		t.Errorf("Exchange returned token expiring in %v, want 14 days", d)
+	}
+	if !tok.Valid() {
+		t.Error("Exchange returned an invalid token")
+	}
+}
+
+func TestOAuthConfigExchangeFail(t *testing.T) {
+	ts, config := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "Specified code was invalid or expired"}`)
+	})
+	defer ts.Close()
+
+	_, err := config.Exchange(context.Background(), "expired")
+	e, ok := err.(*OAuthError)
+	if !ok {
+		t.Fatalf("Exchange returned error %#v, want *OAuthError", err)
+	}
+	if e.StatusCode != http.StatusBadRequest || e.Code != "invalid_grant" || e.Description != "Specified code was invalid or expired" {
+		t.Errorf("Exchange returned error %+v", e)
+	}
+}
+
+func TestTokenSourceRefresh(t *testing.T) {
+	refreshes := 0
+	ts, config := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		refreshes++
+		if form.Get("grant_type") != "refresh_token" || form.Get("refresh_token") != "old-refresh" {
+			t.Errorf("Token request form = %v", form)
+		}
+		_, _ = fmt.Fprint(w, `{"access_token": "new-access", "expires_in": 3600, "refresh_token": "new-refresh"}`)
+	})
+	defer ts.Close()
+
+	store := NewMemoryTokenStore(&Token{AccessToken: "old-access", RefreshToken: "old-refresh", Expiry: time.Now().Add(-time.Minute)})
+	src := config.TokenSource(store)
+
+	for i := 0; i < 2; i++ {
+		tok, err := src.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token returned error: %v", err)
+		}
+		if tok.AccessToken != "new-access" {
+			t.Errorf("Token returned access token %q, want %q", tok.AccessToken, "new-access")
+		}
+	}
+	if refreshes != 1 {
+		t.Errorf("Token refreshed %d times, want 1", refreshes)
+	}
+
+	saved, _ := store.Load(context.Background())
+	if saved.AccessToken != "new-access" || saved.RefreshToken != "new-refresh" {
+		t.Errorf("TokenStore holds %+v after refresh", saved)
+	}
+}
+
+func TestTokenSourceNoToken(t *testing.T) {
+	config := &OAuthConfig{}
+	_, err := config.TokenSource(NewMemoryTokenStore(nil)).Token(context.Background())
+	if err == nil {
+		t.Error("Token returned no error for an empty store")
+	}
+}
+
+func TestOAuthTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "Bearer SlAV32hkKG"; got != want {
+			t.Errorf("Authorization header = %q, want %q", got, want)
+		}
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	config := &OAuthConfig{}
+	src := config.TokenSource(NewMemoryTokenStore(&Token{AccessToken: "SlAV32hkKG", Expiry: time.Now().Add(time.Hour)}))
+	c := NewAPIClient(&http.Client{Transport: &OAuthTransport{Source: src}})
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	if _, err := c.ListClients(); err != nil {
+		t.Errorf("ListClients returned error: %v", err)
+	}
+}
+
+func TestOAuthTransportRenew(t *testing.T) {
+	setup()
+	defer teardown()
+
+	refreshes := 0
+	ts, config := tokenServer(t, func(w http.ResponseWriter, form url.Values) {
+		refreshes++
+		_, _ = fmt.Fprint(w, `{"access_token": "new-access", "expires_in": 3600}`)
+	})
+	defer ts.Close()
+
+	requests := 0
+	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"EmailAddress":"alice@example.com"}` + "\n"; string(body) != want {
+			t.Errorf("Attempt %d request body = %q, want %q", requests, body, want)
+		}
+		if r.Header.Get("Authorization") != "Bearer new-access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"Code": 121, "Message": "Expired OAuth Token"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `"alice@example.com"`)
+	})
+
+	// The token's expiry is unknown, so only the API's response reveals
+	// that it expired.
+	store := NewMemoryTokenStore(&Token{AccessToken: "old-access", RefreshToken: "old-refresh"})
+	c := NewAPIClient(&http.Client{Transport: &OAuthTransport{Source: config.TokenSource(store)}})
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	if err := c.AddSubscriber("12CD", NewSubscriber{EmailAddress: "alice@example.com"}); err != nil {
+		t.Errorf("AddSubscriber returned error: %v", err)
+	}
+	if requests != 2 || refreshes != 1 {
+		t.Errorf("Server received %d requests and %d refreshes, want 2 and 1", requests, refreshes)
+	}
+	if saved, _ := store.Load(context.Background()); saved.AccessToken != "new-access" || saved.RefreshToken != "old-refresh" {
+		t.Errorf("TokenStore holds %+v after renewal", saved)
+	}
+}
+
+func TestOAuthTransportUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"Code": 100, "Message": "Invalid API Key"}`)
+	})
+
+	config := &OAuthConfig{TokenURL: "http://127.0.0.1:0/unused"}
+	src := config.TokenSource(NewMemoryTokenStore(&Token{AccessToken: "SlAV32hkKG", RefreshToken: "r"}))
+	c := NewAPIClient(&http.Client{Transport: &OAuthTransport{Source: src}})
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	_, err := c.ListClients()
+	var e *Error
+	if !errors.As(err, &e) || e.Code != CodeInvalidAPIKey {
+		t.Errorf("ListClients returned %v, want createsend error %d", err, CodeInvalidAPIKey)
+	}
+}
@@ -0,0 +1,118 @@
+package createsend
+
+import "context"
+
+// fetchPageFunc fetches the given page of a paginated API response. It returns
+// the page's results and the total number of pages.
+type fetchPageFunc func(ctx context.Context, page int) (results interface{}, numberOfPages int, err error)
+
+// fetchedPage is the outcome of a single fetchPageFunc call.
+type fetchedPage struct {
+	results       interface{}
+	numberOfPages int
+	err           error
+}
+
+// pager walks the pages of a paginated API response, optionally fetching
+// pages ahead of the caller concurrently. It is the engine behind the typed
+// iterators in this package, such as SubscriberIterator.
+type pager struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	fetch    fetchPageFunc
+	prefetch int
+
+	next    int // next page to fetch when not prefetching
+	last    int // number of pages, once started
+	started bool
+	queue   chan chan fetchedPage
+
+	err  error
+	done bool
+}
+
+// newPager returns a pager that starts at page first (or 1 if first is not
+// positive) and keeps up to prefetch pages in flight ahead of the caller.
+func newPager(ctx context.Context, first, prefetch int, fetch fetchPageFunc) *pager {
+	if first < 1 {
+		first = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &pager{ctx: ctx, cancel: cancel, fetch: fetch, prefetch: prefetch, next: first}
+}
+
+// nextPage returns the results of the next page. It returns false when there
+// are no more pages, when an error occurred (available in p.err) or after
+// close was called.
+func (p *pager) nextPage() (interface{}, bool) {
+	if p.done {
+		return nil, false
+	}
+
+	var pg fetchedPage
+	switch {
+	case p.queue != nil:
+		ch, ok := <-p.queue
+		if !ok {
+			p.finish(p.ctx.Err())
+			return nil, false
+		}
+		pg = <-ch
+	case p.started && p.next > p.last:
+		p.finish(nil)
+		return nil, false
+	default:
+		pg.results, pg.numberOfPages, pg.err = p.fetch(p.ctx, p.next)
+	}
+	if pg.err != nil {
+		p.finish(pg.err)
+		return nil, false
+	}
+
+	if !p.started {
+		p.started = true
+		p.last = pg.numberOfPages
+		if p.prefetch > 0 && p.next < p.last {
+			p.startPrefetch(p.next+1, p.last)
+		}
+	}
+	p.next++
+	return pg.results, true
+}
+
+// startPrefetch fetches pages from through to in the background, keeping up
+// to p.prefetch of them queued ahead of the caller. Pages are delivered in
+// order on p.queue, which is closed once all pages were queued or p.ctx is
+// done.
+func (p *pager) startPrefetch(from, to int) {
+	p.queue = make(chan chan fetchedPage, p.prefetch)
+	go func() {
+		defer close(p.queue)
+		for n := from; n <= to; n++ {
+			ch := make(chan fetchedPage, 1)
+			select {
+			case p.queue <- ch:
+			case <-p.ctx.Done():
+				return
+			}
+			go func(n int) {
+				var pg fetchedPage
+				pg.results, pg.numberOfPages, pg.err = p.fetch(p.ctx, n)
+				ch <- pg
+			}(n)
+		}
+	}()
+}
+
+func (p *pager) finish(err error) {
+	if !p.done {
+		p.done = true
+		p.err = err
+		p.cancel()
+	}
+}
+
+// close stops the pager early, canceling any requests still in flight.
+func (p *pager) close() {
+	p.finish(nil)
+}
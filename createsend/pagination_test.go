@@ -0,0 +1,132 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakePages returns a fetchPageFunc serving numberOfPages pages whose results
+// are the page numbers, failing with errPage on page failOn. It records the
+// pages requested in fetched.
+func fakePages(numberOfPages, failOn int, fetched *[]int, mu *sync.Mutex) fetchPageFunc {
+	return func(ctx context.Context, page int) (interface{}, int, error) {
+		mu.Lock()
+		*fetched = append(*fetched, page)
+		mu.Unlock()
+		if page == failOn {
+			return nil, 0, errPage
+		}
+		return page, numberOfPages, nil
+	}
+}
+
+var errPage = errors.New("page failed")
+
+func collectPages(p *pager) []int {
+	var got []int
+	for {
+		results, ok := p.nextPage()
+		if !ok {
+			return got
+		}
+		got = append(got, results.(int))
+	}
+}
+
+func TestPager(t *testing.T) {
+	for _, prefetch := range []int{0, 1, 3} {
+		t.Run(fmt.Sprint("prefetch=", prefetch), func(t *testing.T) {
+			var mu sync.Mutex
+			var fetched []int
+			p := newPager(context.Background(), 2, prefetch, fakePages(5, 0, &fetched, &mu))
+
+			got := collectPages(p)
+			if want := []int{2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+				t.Errorf("pager returned pages %v, want %v", got, want)
+			}
+			if p.err != nil {
+				t.Errorf("pager returned error: %v", p.err)
+			}
+		})
+	}
+}
+
+func TestPager_noResults(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []int
+	p := newPager(context.Background(), 0, 2, fakePages(0, 0, &fetched, &mu))
+
+	got := collectPages(p)
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pager returned pages %v, want %v", got, want)
+	}
+	if want := []int{1}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("pager fetched pages %v, want %v", fetched, want)
+	}
+}
+
+func TestPager_error(t *testing.T) {
+	for _, prefetch := range []int{0, 2} {
+		t.Run(fmt.Sprint("prefetch=", prefetch), func(t *testing.T) {
+			var mu sync.Mutex
+			var fetched []int
+			p := newPager(context.Background(), 1, prefetch, fakePages(5, 3, &fetched, &mu))
+
+			got := collectPages(p)
+			if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+				t.Errorf("pager returned pages %v, want %v", got, want)
+			}
+			if p.err != errPage {
+				t.Errorf("pager returned error %v, want %v", p.err, errPage)
+			}
+			if _, ok := p.nextPage(); ok {
+				t.Error("pager returned a page after an error")
+			}
+		})
+	}
+}
+
+func TestPager_close(t *testing.T) {
+	var mu sync.Mutex
+	var fetched []int
+	p := newPager(context.Background(), 1, 0, fakePages(5, 0, &fetched, &mu))
+
+	if _, ok := p.nextPage(); !ok {
+		t.Fatal("pager returned no pages")
+	}
+	p.close()
+	if _, ok := p.nextPage(); ok {
+		t.Error("pager returned a page after close")
+	}
+	if p.err != nil {
+		t.Errorf("pager returned error %v after close, want nil", p.err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("pager fetched pages %v, want %v", fetched, want)
+	}
+}
+
+func TestPager_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(ctx context.Context, page int) (interface{}, int, error) {
+		if page > 1 {
+			cancel()
+			<-ctx.Done()
+			return nil, 0, ctx.Err()
+		}
+		return page, 5, nil
+	}
+	p := newPager(ctx, 1, 2, fetch)
+
+	got := collectPages(p)
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pager returned pages %v, want %v", got, want)
+	}
+	if p.err != context.Canceled {
+		t.Errorf("pager returned error %v, want %v", p.err, context.Canceled)
+	}
+}
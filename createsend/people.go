@@ -0,0 +1,238 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// AccessLevel is a set of permissions a person has within a client. Access
+// levels are combined with the bitwise OR operator.
+//
+// See https://www.campaignmonitor.com/api/clients/#adding_a_person for more
+// information.
+type AccessLevel int
+
+const (
+	AccessReports           AccessLevel = 1
+	AccessSubscribers       AccessLevel = 2
+	AccessCreateSend        AccessLevel = 4
+	AccessDesignSpamTest    AccessLevel = 8
+	AccessImportSubscribers AccessLevel = 16
+	AccessImportURL         AccessLevel = 32
+	AccessManageLists       AccessLevel = 64
+
+	// AccessFull grants all of the above.
+	AccessFull = AccessReports | AccessSubscribers | AccessCreateSend | AccessDesignSpamTest |
+		AccessImportSubscribers | AccessImportURL | AccessManageLists
+)
+
+// Has reports whether a includes all the permissions of level.
+func (a AccessLevel) Has(level AccessLevel) bool {
+	return a&level == level
+}
+
+// Person represents a person who can log into a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_people for more
+// information.
+type Person struct {
+	EmailAddress string
+	Name         string
+	AccessLevel  AccessLevel
+	Status       PersonStatus
+}
+
+// NewPerson represents a person to be added with AddPerson or updated with
+// UpdatePerson. Password is only used by AddPerson.
+//
+// See https://www.campaignmonitor.com/api/clients/#adding_a_person for more
+// information.
+type NewPerson struct {
+	EmailAddress string
+	Name         string
+	AccessLevel  AccessLevel
+	Password     string `json:",omitempty"`
+}
+
+// People lists the people who can log into a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_people for more
+// information.
+func (c *APIClient) People(clientID string) ([]*Person, error) {
+	return c.PeopleContext(context.Background(), clientID)
+}
+
+// PeopleContext is like People but uses ctx for the API request.
+func (c *APIClient) PeopleContext(ctx context.Context, clientID string) ([]*Person, error) {
+	u := fmt.Sprintf("clients/%s/people.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var people []*Person
+	err = c.Do(req, &people)
+	if err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+// PersonDetails returns the details of the client's person with the given
+// email address.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_person_details for
+// more information.
+func (c *APIClient) PersonDetails(clientID string, email string) (*Person, error) {
+	return c.PersonDetailsContext(context.Background(), clientID, email)
+}
+
+// PersonDetailsContext is like PersonDetails but uses ctx for the API request.
+func (c *APIClient) PersonDetailsContext(ctx context.Context, clientID string, email string) (*Person, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("clients/%s/people.json?%s", clientID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var p Person
+	err = c.Do(req, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// AddPerson adds a person to a client and returns their email address.
+//
+// See https://www.campaignmonitor.com/api/clients/#adding_a_person for more
+// information.
+func (c *APIClient) AddPerson(clientID string, person NewPerson) (string, error) {
+	return c.AddPersonContext(context.Background(), clientID, person)
+}
+
+// AddPersonContext is like AddPerson but uses ctx for the API request.
+func (c *APIClient) AddPersonContext(ctx context.Context, clientID string, person NewPerson) (string, error) {
+	u := fmt.Sprintf("clients/%s/people.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "POST", u, person)
+	if err != nil {
+		return "", err
+	}
+
+	var v struct{ EmailAddress string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return "", err
+	}
+	return v.EmailAddress, nil
+}
+
+// UpdatePerson updates the client's person with the given email address.
+//
+// See https://www.campaignmonitor.com/api/clients/#updating_a_person for more
+// information.
+func (c *APIClient) UpdatePerson(clientID string, email string, person NewPerson) error {
+	return c.UpdatePersonContext(context.Background(), clientID, email, person)
+}
+
+// UpdatePersonContext is like UpdatePerson but uses ctx for the API request.
+func (c *APIClient) UpdatePersonContext(ctx context.Context, clientID string, email string, person NewPerson) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("clients/%s/people.json?%s", clientID, url.Values{"email": {email}}.Encode())
+
+	person.Password = ""
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, person)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// DeletePerson removes the person with the given email address from a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#deleting_a_person for more
+// information.
+func (c *APIClient) DeletePerson(clientID string, email string) error {
+	return c.DeletePersonContext(context.Background(), clientID, email)
+}
+
+// DeletePersonContext is like DeletePerson but uses ctx for the API request.
+func (c *APIClient) DeletePersonContext(ctx context.Context, clientID string, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("clients/%s/people.json?%s", clientID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// ClientPrimaryContact returns the email address of the person who is the
+// primary contact of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_primary_contact for
+// more information.
+func (c *APIClient) ClientPrimaryContact(clientID string) (string, error) {
+	return c.ClientPrimaryContactContext(context.Background(), clientID)
+}
+
+// ClientPrimaryContactContext is like ClientPrimaryContact but uses ctx for the
+// API request.
+func (c *APIClient) ClientPrimaryContactContext(ctx context.Context, clientID string) (string, error) {
+	u := fmt.Sprintf("clients/%s/primarycontact.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var v struct{ EmailAddress string }
+	err = c.Do(req, &v)
+	if err != nil {
+		return "", err
+	}
+	return v.EmailAddress, nil
+}
+
+// SetClientPrimaryContact makes the client's person with the given email
+// address its primary contact.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_primary_contact for
+// more information.
+func (c *APIClient) SetClientPrimaryContact(clientID string, email string) error {
+	return c.SetClientPrimaryContactContext(context.Background(), clientID, email)
+}
+
+// SetClientPrimaryContactContext is like SetClientPrimaryContact but uses ctx
+// for the API request.
+func (c *APIClient) SetClientPrimaryContactContext(ctx context.Context, clientID string, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("clients/%s/primarycontact.json?%s", clientID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
@@ -0,0 +1,158 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccessLevelHas(t *testing.T) {
+	a := AccessReports | AccessManageLists
+	if !a.Has(AccessReports) || !a.Has(AccessManageLists) || !a.Has(AccessReports|AccessManageLists) {
+		t.Errorf("AccessLevel(%d) is missing a granted level", a)
+	}
+	if a.Has(AccessCreateSend) || a.Has(AccessReports|AccessCreateSend) {
+		t.Errorf("AccessLevel(%d) has a level that was not granted", a)
+	}
+	if !AccessFull.Has(AccessImportURL) {
+		t.Error("AccessFull is missing AccessImportURL")
+	}
+}
+
+func TestPeople(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/people.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[{"EmailAddress": "alice@example.com", "Name": "Alice", "AccessLevel": 23, "Status": "Active"}]`)
+	})
+
+	people, err := client.People("12ab")
+	if err != nil {
+		t.Errorf("People returned error: %v", err)
+	}
+
+	want := []*Person{{
+		EmailAddress: "alice@example.com",
+		Name:         "Alice",
+		AccessLevel:  AccessReports | AccessSubscribers | AccessCreateSend | AccessImportSubscribers,
+		Status:       PersonActive,
+	}}
+	if !reflect.DeepEqual(people, want) {
+		t.Errorf("People returned %+v, want %+v", people, want)
+	}
+}
+
+func TestPersonDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/people.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "email=alice%40example.com")
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "alice@example.com", "Name": "Alice", "AccessLevel": 1, "Status": "Waiting to Accept the Invitation"}`)
+	})
+
+	p, err := client.PersonDetails("12ab", "alice@example.com")
+	if err != nil {
+		t.Errorf("PersonDetails returned error: %v", err)
+	}
+
+	want := &Person{EmailAddress: "alice@example.com", Name: "Alice", AccessLevel: AccessReports, Status: PersonInvited}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("PersonDetails returned %+v, want %+v", p, want)
+	}
+}
+
+func TestAddPerson(t *testing.T) {
+	setup()
+	defer teardown()
+
+	person := NewPerson{EmailAddress: "alice@example.com", Name: "Alice", AccessLevel: AccessFull, Password: "s3cret"}
+	mux.HandleFunc("/clients/12ab/people.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got NewPerson
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got != person {
+			t.Errorf("Request body = %+v, want %+v", got, person)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"EmailAddress": "alice@example.com"}`)
+	})
+
+	email, err := client.AddPerson("12ab", person)
+	if err != nil {
+		t.Errorf("AddPerson returned error: %v", err)
+	}
+	if email != "alice@example.com" {
+		t.Errorf("AddPerson returned %q", email)
+	}
+}
+
+func TestUpdatePerson(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/people.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testQuerystring(t, r, "email=alice%40example.com")
+		var got map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if _, ok := got["Password"]; ok {
+			t.Errorf("Request body contains a password: %+v", got)
+		}
+	})
+
+	err := client.UpdatePerson("12ab", "alice@example.com", NewPerson{EmailAddress: "alice@example.net", Name: "Alice", AccessLevel: AccessReports, Password: "ignored"})
+	if err != nil {
+		t.Errorf("UpdatePerson returned error: %v", err)
+	}
+}
+
+func TestDeletePerson(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/people.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testQuerystring(t, r, "email=alice%40example.com")
+	})
+
+	err := client.DeletePerson("12ab", "alice@example.com")
+	if err != nil {
+		t.Errorf("DeletePerson returned error: %v", err)
+	}
+}
+
+func TestClientPrimaryContact(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/primarycontact.json", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			_, _ = fmt.Fprint(w, `{"EmailAddress": "alice@example.com"}`)
+		case "PUT":
+			testQuerystring(t, r, "email=bob%40example.com")
+			_, _ = fmt.Fprint(w, `{"EmailAddress": "bob@example.com"}`)
+		default:
+			t.Errorf("Unexpected request method %s", r.Method)
+		}
+	})
+
+	email, err := client.ClientPrimaryContact("12ab")
+	if err != nil {
+		t.Errorf("ClientPrimaryContact returned error: %v", err)
+	}
+	if email != "alice@example.com" {
+		t.Errorf("ClientPrimaryContact returned %q", email)
+	}
+
+	err = client.SetClientPrimaryContact("12ab", "bob@example.com")
+	if err != nil {
+		t.Errorf("SetClientPrimaryContact returned error: %v", err)
+	}
+}
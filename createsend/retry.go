@@ -0,0 +1,172 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how an APIClient retries requests that fail with a
+// transient error: a network error, HTTP 429 (Too Many Requests) or an HTTP
+// 5xx gateway or server error.
+//
+// Only idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried,
+// unless the request's context was marked with AllowRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent,
+	// including the first attempt. Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. It doubles with each
+	// subsequent retry, and a random jitter of up to half the delay is
+	// subtracted from it.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts. If the API asks for a
+	// longer delay using the Retry-After header, the request is not retried.
+	// Zero means no cap.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is a reasonable RetryPolicy for most applications.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+type allowRetryKey struct{}
+
+// AllowRetry returns a copy of ctx that marks requests made with it as safe to
+// retry even if their method is not idempotent, such as the POST requests
+// made by AddSubscriber or ImportSubscribers.
+func AllowRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allowRetryKey{}, true)
+}
+
+// send sends req, retrying it according to c.Retry.
+func (c *APIClient) send(req *http.Request) (*http.Response, error) {
+	p := c.Retry
+	if p == nil || p.MaxAttempts < 2 || !canRetry(req) {
+		return c.client.Do(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := c.client.Do(req)
+		if attempt >= p.MaxAttempts || ctx.Err() != nil || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+		if resp != nil {
+			if d, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				if p.MaxDelay > 0 && d > p.MaxDelay {
+					return resp, err
+				}
+				delay = d
+			}
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if c.Log != nil {
+			if err != nil {
+				c.Log.Printf("%s %s failed: %s; retrying in %s (attempt %d of %d)", req.Method, req.URL, err, delay, attempt+1, p.MaxAttempts)
+			} else {
+				c.Log.Printf("%s %s returned status %d; retrying in %s (attempt %d of %d)", req.Method, req.URL, resp.StatusCode, delay, attempt+1, p.MaxAttempts)
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req = req.Clone(ctx)
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// backoff returns the jittered delay to wait after the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if half := int64(d / 2); half > 0 {
+		d -= time.Duration(rand.Int63n(half))
+	}
+	return d
+}
+
+// canRetry reports whether req may safely be sent more than once.
+func canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	allow, _ := req.Context().Value(allowRetryKey{}).(bool)
+	return allow
+}
+
+// shouldRetry reports whether the outcome of an attempt is transient.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		var nerr net.Error
+		return errors.As(err, &nerr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
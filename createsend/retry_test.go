@@ -0,0 +1,217 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// flapping registers a handler on mux for pattern that responds with status
+// to the first failures requests and with body afterwards. It returns a
+// pointer to the number of requests received.
+func flapping(pattern string, failures int, status int, body string) *int {
+	n := 0
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if n <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = fmt.Fprint(w, body)
+	})
+	return &n
+}
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	n := flapping("/clients.json", 2, http.StatusServiceUnavailable, `[{"ClientID": "12ab", "Name": "Alice"}]`)
+
+	clients, err := client.ListClients()
+	if err != nil {
+		t.Fatalf("ListClients returned error: %v", err)
+	}
+	if len(clients) != 1 {
+		t.Errorf("ListClients returned %+v, want 1 client", clients)
+	}
+	if *n != 3 {
+		t.Errorf("Server received %d requests, want 3", *n)
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	n := flapping("/clients.json", 5, http.StatusTooManyRequests, `[]`)
+
+	_, err := client.ListClients()
+	if err == nil {
+		t.Error("ListClients returned no error")
+	}
+	if *n != 3 {
+		t.Errorf("Server received %d requests, want 3", *n)
+	}
+}
+
+func TestDo_retryDisabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	n := flapping("/clients.json", 1, http.StatusServiceUnavailable, `[]`)
+
+	_, err := client.ListClients()
+	if err == nil {
+		t.Error("ListClients returned no error")
+	}
+	if *n != 1 {
+		t.Errorf("Server received %d requests, want 1", *n)
+	}
+}
+
+func TestDo_retryNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	n := flapping("/clients.json", 1, http.StatusNotFound, `[]`)
+
+	_, err := client.ListClients()
+	if err == nil {
+		t.Error("ListClients returned no error")
+	}
+	if *n != 1 {
+		t.Errorf("Server received %d requests, want 1", *n)
+	}
+}
+
+func TestDo_retryPOST(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	n := flapping("/subscribers/12CD.json", 1, http.StatusServiceUnavailable, `"alice@example.com"`)
+
+	err := client.AddSubscriber("12CD", NewSubscriber{EmailAddress: "alice@example.com"})
+	if err == nil {
+		t.Error("AddSubscriber returned no error")
+	}
+	if *n != 1 {
+		t.Errorf("Server received %d requests without AllowRetry, want 1", *n)
+	}
+}
+
+func TestDo_retryPOSTAllowed(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	n := 0
+	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		n++
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"EmailAddress":"alice@example.com"}` + "\n"; string(body) != want {
+			t.Errorf("Attempt %d request body = %q, want %q", n, body, want)
+		}
+		if n == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = fmt.Fprint(w, `"alice@example.com"`)
+	})
+
+	ctx := AllowRetry(context.Background())
+	err := client.AddSubscriberContext(ctx, "12CD", NewSubscriber{EmailAddress: "alice@example.com"})
+	if err != nil {
+		t.Errorf("AddSubscriberContext returned error: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Server received %d requests, want 2", n)
+	}
+}
+
+func TestDo_retryCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.ListClientsContext(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("ListClientsContext returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestDo_retryAfterTooLong(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+
+	n := 0
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		n++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.ListClients()
+	if err == nil {
+		t.Error("ListClients returned no error")
+	}
+	if n != 1 {
+		t.Errorf("Server received %d requests, want 1", n)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"Wed, 21 Oct 2015 07:28:30 GMT", 30 * time.Second, true},
+		{"Wed, 21 Oct 2015 07:27:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.in, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		if d := p.backoff(tt.attempt); d < tt.min || d > tt.max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", tt.attempt, d, tt.min, tt.max)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
-	"strings"
 )
 
 type SegmentCreate struct {
@@ -24,9 +24,14 @@ type RuleCreate struct {
 // See https://www.campaignmonitor.com/api/segments/#creating_a_segment for more
 // information.
 func (c *APIClient) SegmentCreate(listID string, sgmt *SegmentCreate) (string, error) {
+	return c.SegmentCreateContext(context.Background(), listID, sgmt)
+}
+
+// SegmentCreateContext is like SegmentCreate but uses ctx for the API request.
+func (c *APIClient) SegmentCreateContext(ctx context.Context, listID string, sgmt *SegmentCreate) (string, error) {
 	u := fmt.Sprintf("segments/%s.json", listID)
 
-	req, err := c.NewRequest("POST", u, sgmt)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, sgmt)
 	if err != nil {
 		return "", err
 	}
@@ -34,13 +39,7 @@ func (c *APIClient) SegmentCreate(listID string, sgmt *SegmentCreate) (string, e
 	var r string
 	err = c.Do(req, &r)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return r, nil
-		} else {
-			return r, err
-		}
+		return "", err
 	}
 
 	return r, nil
@@ -51,22 +50,21 @@ func (c *APIClient) SegmentCreate(listID string, sgmt *SegmentCreate) (string, e
 // See https://www.campaignmonitor.com/api/segments/#updating_a_segment for more
 // information.
 func (c *APIClient) SegmentUpdate(segmentID string, sgmt *SegmentCreate) error {
+	return c.SegmentUpdateContext(context.Background(), segmentID, sgmt)
+}
+
+// SegmentUpdateContext is like SegmentUpdate but uses ctx for the API request.
+func (c *APIClient) SegmentUpdateContext(ctx context.Context, segmentID string, sgmt *SegmentCreate) error {
 	u := fmt.Sprintf("segments/%s.json", segmentID)
 
-	req, err := c.NewRequest("PUT", u, sgmt)
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, sgmt)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return err
@@ -85,9 +83,14 @@ type SegmentDetail struct {
 // See https://www.campaignmonitor.com/api/segments/#getting_a_segments_details for more
 // information.
 func (c *APIClient) SegmentDetail(segmentID string) (*SegmentDetail, error) {
+	return c.SegmentDetailContext(context.Background(), segmentID)
+}
+
+// SegmentDetailContext is like SegmentDetail but uses ctx for the API request.
+func (c *APIClient) SegmentDetailContext(ctx context.Context, segmentID string) (*SegmentDetail, error) {
 	u := fmt.Sprintf("segments/%s.json", segmentID)
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -95,13 +98,7 @@ func (c *APIClient) SegmentDetail(segmentID string) (*SegmentDetail, error) {
 	var s SegmentDetail
 	err = c.Do(req, &s)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return &s, nil
-		} else {
-			return nil, err
-		}
+		return nil, err
 	}
 
 	return &s, nil
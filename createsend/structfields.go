@@ -0,0 +1,373 @@
+package createsend
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Structs are mapped to subscribers using the "createsend" struct tag. A tag
+// naming a key in brackets maps the field to that custom field; the tags
+// "EmailAddress" and "Name" map it to the subscriber's email address and
+// name:
+//
+//	type User struct {
+//		Email     string    `createsend:"EmailAddress"`
+//		FullName  string    `createsend:"Name"`
+//		Plan      string    `createsend:"[Plan]"`
+//		Seats     int       `createsend:"[Seats],omitempty"`
+//		Renewal   time.Time `createsend:"[RenewalDate],omitempty"`
+//		Interests []string  `createsend:"[Interests]"`
+//		Password  string    // not mapped
+//	}
+//
+// Mapped fields must be strings, numbers, time.Time values (encoded in
+// YYYY/MM/DD format), string slices (encoded as one custom field per element,
+// as multi-select fields are) or pointers to these. Nil pointers are never
+// encoded, and neither are zero values of fields with the omitempty option.
+// Fields of embedded structs are mapped as if they belonged to the outer
+// struct.
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// taggedField is a struct field mapped with a "createsend" tag.
+type taggedField struct {
+	name      string // tag name, such as "[Plan]" or "EmailAddress"
+	index     []int
+	omitEmpty bool
+}
+
+// isCustom reports whether f maps to a custom field.
+func (f taggedField) isCustom() bool {
+	return strings.HasPrefix(f.name, "[") && strings.HasSuffix(f.name, "]")
+}
+
+// taggedFields returns the mapped fields of struct type t.
+func taggedFields(t reflect.Type) ([]taggedField, error) {
+	var fields []taggedField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("createsend")
+		if !ok {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				inner, err := taggedFields(sf.Type)
+				if err != nil {
+					return nil, err
+				}
+				for _, f := range inner {
+					f.index = append([]int{i}, f.index...)
+					fields = append(fields, f)
+				}
+			}
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		f := taggedField{name: parts[0], index: []int{i}}
+		for _, opt := range parts[1:] {
+			if opt != "omitempty" {
+				return nil, fmt.Errorf("createsend: field %s.%s: unknown tag option %q", t, sf.Name, opt)
+			}
+			f.omitEmpty = true
+		}
+		if !f.isCustom() && f.name != "EmailAddress" && f.name != "Name" {
+			return nil, fmt.Errorf("createsend: field %s.%s: tag %q is neither EmailAddress, Name nor a custom field key in brackets", t, sf.Name, f.name)
+		}
+		if sf.PkgPath != "" {
+			return nil, fmt.Errorf("createsend: field %s.%s is tagged but not exported", t, sf.Name)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// structValue returns the struct v points to or holds.
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, errors.New("createsend: nil pointer to struct")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("createsend: %T is not a struct", v)
+	}
+	return rv, nil
+}
+
+// encodeStruct returns the email address, name and custom fields of v.
+func encodeStruct(v interface{}) (email, name string, custom []CustomField, err error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return "", "", nil, err
+	}
+	fields, err := taggedFields(rv.Type())
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		switch {
+		case f.name == "EmailAddress" || f.name == "Name":
+			if fv.Kind() != reflect.String {
+				return "", "", nil, fmt.Errorf("createsend: field for %s has type %s, want string", f.name, fv.Type())
+			}
+			if f.name == "EmailAddress" {
+				email = fv.String()
+			} else {
+				name = fv.String()
+			}
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+			for i := 0; i < fv.Len(); i++ {
+				custom = append(custom, CustomField{Key: f.name, Value: fv.Index(i).String()})
+			}
+		default:
+			value, err := encodeFieldValue(fv)
+			if err != nil {
+				return "", "", nil, &CustomFieldError{Key: f.name, Value: fv.Interface(), Reason: err.Error()}
+			}
+			custom = append(custom, CustomField{Key: f.name, Value: value})
+		}
+	}
+	return email, name, custom, nil
+}
+
+func encodeFieldValue(fv reflect.Value) (interface{}, error) {
+	if fv.Type() == timeType {
+		return fv.Interface().(time.Time).Format(customFieldDateFormat), nil
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fv.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return fv.Float(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", fv.Type())
+}
+
+// EncodeSubscriber returns a NewSubscriber holding the email address, name and
+// custom fields that the struct v (or pointer to struct) maps with "createsend"
+// tags. Check the result with CustomFieldSchema.Validate to catch values that
+// do not suit the list's fields.
+func EncodeSubscriber(v interface{}) (NewSubscriber, error) {
+	email, name, custom, err := encodeStruct(v)
+	if err != nil {
+		return NewSubscriber{}, err
+	}
+	return NewSubscriber{EmailAddress: email, Name: name, CustomFields: custom}, nil
+}
+
+// EncodeImportSubscriber is like EncodeSubscriber but returns an
+// ImportSubscriber, for use with ImportSubscribers.
+func EncodeImportSubscriber(v interface{}) (ImportSubscriber, error) {
+	email, name, custom, err := encodeStruct(v)
+	if err != nil {
+		return ImportSubscriber{}, err
+	}
+	return ImportSubscriber{EmailAddress: email, Name: name, CustomFields: custom}, nil
+}
+
+// DecodeSubscriber sets the fields of the struct v points to from sub,
+// according to their "createsend" tags. Custom fields without a matching
+// struct field are ignored, and struct fields without a matching custom field
+// are left unchanged.
+func DecodeSubscriber(sub *Subscriber, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("createsend: DecodeSubscriber needs a non-nil pointer to a struct, not %T", v)
+	}
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+	fields, err := taggedFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string][]interface{}, len(sub.CustomFields))
+	for _, cf := range sub.CustomFields {
+		k := CustomFieldKey(cf.Key)
+		values[k] = append(values[k], cf.Value)
+	}
+
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+
+		var vs []interface{}
+		switch f.name {
+		case "EmailAddress":
+			vs = []interface{}{sub.EmailAddress}
+		case "Name":
+			vs = []interface{}{sub.Name}
+		default:
+			var ok bool
+			vs, ok = values[CustomFieldKey(f.name)]
+			if !ok {
+				continue
+			}
+		}
+
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			fv = fv.Elem()
+		}
+		if err := decodeFieldValue(fv, vs); err != nil {
+			return &CustomFieldError{Key: f.name, Value: vs[0], Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+// decodeFieldValue sets fv from the values of a custom field.
+func decodeFieldValue(fv reflect.Value, vs []interface{}) error {
+	if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String {
+		s := reflect.MakeSlice(fv.Type(), 0, len(vs))
+		for _, v := range vs {
+			s = reflect.Append(s, reflect.ValueOf(fmt.Sprint(v)).Convert(fv.Type().Elem()))
+		}
+		fv.Set(s)
+		return nil
+	}
+
+	str := fmt.Sprint(vs[0])
+	if fv.Type() == timeType {
+		if str == "" {
+			fv.Set(reflect.Zero(timeType))
+			return nil
+		}
+		t, err := parseCustomFieldDate(str)
+		if err != nil {
+			return errors.New("not a date in YYYY/MM/DD format")
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(str)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if str == "" {
+			fv.SetInt(0)
+			return nil
+		}
+		n, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			f, ferr := strconv.ParseFloat(str, 64)
+			if ferr != nil || f != float64(int64(f)) {
+				return fmt.Errorf("cannot decode %q into %s", str, fv.Type())
+			}
+			n = int64(f)
+		}
+		if fv.OverflowInt(n) {
+			return fmt.Errorf("%s overflows %s", str, fv.Type())
+		}
+		fv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if str == "" {
+			fv.SetUint(0)
+			return nil
+		}
+		n, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return fmt.Errorf("cannot decode %q into %s", str, fv.Type())
+		}
+		if fv.OverflowUint(n) {
+			return fmt.Errorf("%s overflows %s", str, fv.Type())
+		}
+		fv.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if str == "" {
+			fv.SetFloat(0)
+			return nil
+		}
+		f, err := strconv.ParseFloat(str, fv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot decode %q into %s", str, fv.Type())
+		}
+		fv.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("unsupported type %s", fv.Type())
+}
+
+// CheckStruct checks the "createsend" tags of the struct v (or pointer to
+// struct) against the schema. It returns a *CustomFieldError for the first
+// tagged custom field the list does not have, or whose Go type cannot hold
+// the field's DataType.
+func (s *CustomFieldSchema) CheckStruct(v interface{}) error {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("createsend: %T is not a struct", v)
+	}
+	fields, err := taggedFields(t)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		if !f.isCustom() {
+			continue
+		}
+		d, ok := s.Definition(f.name)
+		if !ok {
+			return &CustomFieldError{Key: f.name, Reason: "no such field in list"}
+		}
+
+		ft := t.FieldByIndex(f.index).Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		var fits bool
+		switch d.DataType {
+		case Number:
+			switch ft.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+				reflect.Float32, reflect.Float64, reflect.String:
+				fits = true
+			}
+		case Date:
+			fits = ft == timeType || ft.Kind() == reflect.String
+		case MultiSelectMany:
+			fits = ft.Kind() == reflect.String || ft.Kind() == reflect.Slice && ft.Elem().Kind() == reflect.String
+		default:
+			fits = ft.Kind() == reflect.String
+		}
+		if !fits {
+			return &CustomFieldError{Key: f.name, DataType: d.DataType, Reason: fmt.Sprintf("cannot be held by Go type %s", ft)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package createsend
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testAccount struct {
+	Plan string `createsend:"[plan]"`
+}
+
+type testUser struct {
+	testAccount
+	Email     string     `createsend:"EmailAddress"`
+	FullName  string     `createsend:"Name"`
+	Age       int        `createsend:"[age],omitempty"`
+	Birthday  *time.Time `createsend:"[birthday]"`
+	Interests []string   `createsend:"[interests]"`
+	Website   string     `createsend:"[website],omitempty"`
+	Password  string
+	Ignored   string `createsend:"-"`
+}
+
+func TestEncodeSubscriber(t *testing.T) {
+	birthday := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	u := &testUser{
+		testAccount: testAccount{Plan: "Pro"},
+		Email:       "alice@example.com",
+		FullName:    "Alice",
+		Age:         30,
+		Birthday:    &birthday,
+		Interests:   []string{"Go", "Zig"},
+		Password:    "secret",
+		Ignored:     "ignored",
+	}
+
+	sub, err := EncodeSubscriber(u)
+	if err != nil {
+		t.Fatalf("EncodeSubscriber returned error: %v", err)
+	}
+
+	want := NewSubscriber{
+		EmailAddress: "alice@example.com",
+		Name:         "Alice",
+		CustomFields: []CustomField{
+			{Key: "[plan]", Value: "Pro"},
+			{Key: "[age]", Value: int64(30)},
+			{Key: "[birthday]", Value: "1990/05/17"},
+			{Key: "[interests]", Value: "Go"},
+			{Key: "[interests]", Value: "Zig"},
+		},
+	}
+	if !reflect.DeepEqual(sub, want) {
+		t.Errorf("EncodeSubscriber returned %+v, want %+v", sub, want)
+	}
+	if err := testSchema.Validate(sub.CustomFields); err != nil {
+		t.Errorf("Encoded custom fields do not validate: %v", err)
+	}
+
+	imp, err := EncodeImportSubscriber(*u)
+	if err != nil {
+		t.Fatalf("EncodeImportSubscriber returned error: %v", err)
+	}
+	if imp.EmailAddress != want.EmailAddress || !reflect.DeepEqual(imp.CustomFields, want.CustomFields) {
+		t.Errorf("EncodeImportSubscriber returned %+v", imp)
+	}
+}
+
+func TestEncodeSubscriber_errors(t *testing.T) {
+	tests := []interface{}{
+		"not a struct",
+		(*testUser)(nil),
+		&struct {
+			Active bool `createsend:"[active]"`
+		}{true},
+		&struct {
+			Email string `createsend:"Email"`
+		}{},
+		&struct {
+			Plan string `createsend:"[plan],required"`
+		}{},
+	}
+	for _, v := range tests {
+		if _, err := EncodeSubscriber(v); err == nil {
+			t.Errorf("EncodeSubscriber(%#v) returned no error", v)
+		}
+	}
+}
+
+func TestDecodeSubscriber(t *testing.T) {
+	sub := &Subscriber{
+		EmailAddress: "alice@example.com",
+		Name:         "Alice",
+		CustomFields: []CustomField{
+			{Key: "plan", Value: "Pro"},
+			{Key: "age", Value: "30"},
+			{Key: "birthday", Value: "1990/05/17"},
+			{Key: "interests", Value: "Go"},
+			{Key: "interests", Value: "Zig"},
+			{Key: "unmapped", Value: "x"},
+		},
+	}
+
+	u := testUser{Password: "unchanged"}
+	if err := DecodeSubscriber(sub, &u); err != nil {
+		t.Fatalf("DecodeSubscriber returned error: %v", err)
+	}
+
+	birthday := time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)
+	want := testUser{
+		testAccount: testAccount{Plan: "Pro"},
+		Email:       "alice@example.com",
+		FullName:    "Alice",
+		Age:         30,
+		Birthday:    &birthday,
+		Interests:   []string{"Go", "Zig"},
+		Password:    "unchanged",
+	}
+	if !reflect.DeepEqual(u, want) {
+		t.Errorf("DecodeSubscriber set %+v, want %+v", u, want)
+	}
+}
+
+func TestDecodeSubscriber_errors(t *testing.T) {
+	var u testUser
+	if err := DecodeSubscriber(&Subscriber{}, u); err == nil {
+		t.Error("DecodeSubscriber into a non-pointer returned no error")
+	}
+
+	sub := &Subscriber{CustomFields: []CustomField{{Key: "age", Value: "thirty"}}}
+	err := DecodeSubscriber(sub, &u)
+	var cfErr *CustomFieldError
+	if !errors.As(err, &cfErr) || cfErr.Key != "[age]" {
+		t.Errorf("DecodeSubscriber returned error %v, want a *CustomFieldError for [age]", err)
+	}
+}
+
+func TestCustomFieldSchemaCheckStruct(t *testing.T) {
+	if err := testSchema.CheckStruct(testUser{}); err != nil {
+		t.Errorf("CheckStruct returned error: %v", err)
+	}
+
+	tests := []struct {
+		v   interface{}
+		key string
+	}{
+		{&struct {
+			Colour string `createsend:"[colour]"`
+		}{}, "[colour]"},
+		{&struct {
+			Birthday int `createsend:"[birthday]"`
+		}{}, "[birthday]"},
+		{&struct {
+			Age []string `createsend:"[age]"`
+		}{}, "[age]"},
+	}
+	for _, tt := range tests {
+		err := testSchema.CheckStruct(tt.v)
+		var cfErr *CustomFieldError
+		if !errors.As(err, &cfErr) || cfErr.Key != tt.key {
+			t.Errorf("CheckStruct(%T) returned error %v, want a *CustomFieldError for %s", tt.v, err, tt.key)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package createsend
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"net/url"
 	"time"
 )
 
@@ -26,6 +28,10 @@ type NewSubscriber struct {
 type CustomField struct {
 	Key   string
 	Value interface{}
+
+	// Clear removes the field's value when updating a subscriber. Use
+	// ClearCustomField to create such a field.
+	Clear bool `json:",omitempty"`
 }
 
 // AddSubscriber adds a subscriber.
@@ -33,52 +39,65 @@ type CustomField struct {
 // See http://www.campaignmonitor.com/api/subscribers/#adding_a_subscriber for
 // more information.
 func (c *APIClient) AddSubscriber(listID string, sub NewSubscriber) error {
+	return c.AddSubscriberContext(context.Background(), listID, sub)
+}
+
+// AddSubscriberContext is like AddSubscriber but uses ctx for the API request.
+func (c *APIClient) AddSubscriberContext(ctx context.Context, listID string, sub NewSubscriber) error {
 	u := fmt.Sprintf("subscribers/%s.json", listID)
 
-	req, err := c.NewRequest("POST", u, sub)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, sub)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
 }
 
+// Resubscribe is unused.
+//
+// Deprecated: Use NewSubscriber.Resubscribe, or UpsertSubscriber with a
+// ResubscribePolicy.
 type Resubscribe struct {
 	Resubscribe bool `json:""`
 }
 
-// UpdateSubscriber updates a subscriber.
+// UpdateSubscriber updates a subscriber. sub.EmailAddress, if set, is their
+// new email address; like email, it is normalized with NormalizeEmail.
 //
 // See http://www.campaignmonitor.com/api/subscribers/#updating_a_subscriber for
 // more information.
 func (c *APIClient) UpdateSubscriber(listID string, email string, sub NewSubscriber) error {
-	u := fmt.Sprintf("subscribers/%s.json?email=%s", listID, email)
+	return c.UpdateSubscriberContext(context.Background(), listID, email, sub)
+}
 
-	req, err := c.NewRequest("PUT", u, sub)
+// UpdateSubscriberContext is like UpdateSubscriber but uses ctx for the API request.
+func (c *APIClient) UpdateSubscriberContext(ctx context.Context, listID string, email string, sub NewSubscriber) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	if sub.EmailAddress != "" {
+		sub.EmailAddress, err = NormalizeEmail(sub.EmailAddress)
+		if err != nil {
+			return err
+		}
+	}
+	u := fmt.Sprintf("subscribers/%s.json?%s", listID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, sub)
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
@@ -97,11 +116,48 @@ type Subscriber struct {
 	CustomFields   []CustomField `json:",omitempty"`
 	ReadsEmailWith string        `json:",omitempty"`
 
+	// ConsentToTrack is only returned when the tracking preference was
+	// requested, for example with ListSubscribersOptions.
+	ConsentToTrack ConsentToTrack `json:",omitempty"`
+
+	// ListJoinedDate is the date the subscriber joined the list, whereas Date
+	// is the date of their latest state change: for subscribers listed with
+	// UnsubscribedSubscribers, BouncedSubscribers or DeletedSubscribers, the
+	// date they unsubscribed, bounced or were deleted.
+	//
+	// The API returns no details of a bounce beyond its date. Use
+	// SubscriberHistory or CampaignBounces for the bounce type and reason.
+	ListJoinedDate time.Time `json:"-"`
+
 	// DateStr holds the createsend API's date format, which is "2010-10-25
 	// 10:28:00". This is not the format that encoding/json expects, so we must
 	// parse it separately. The parsed date is stored in the Date field, and the
 	// RFC3339 date string is overwritten into this field.
 	DateStr string `json:"date,omitempty"`
+
+	// ListJoinedDateStr is to ListJoinedDate what DateStr is to Date.
+	ListJoinedDateStr string `json:"ListJoinedDate,omitempty"`
+}
+
+// parseDates parses the createsend API dates of sub. (See Subscriber.DateStr
+// field comment.)
+func (sub *Subscriber) parseDates() error {
+	var err error
+	if sub.DateStr != "" {
+		sub.Date, err = time.Parse("2006-01-02 15:04:05", sub.DateStr)
+		if err != nil {
+			return err
+		}
+		sub.DateStr = sub.Date.Format(time.RFC3339)
+	}
+	if sub.ListJoinedDateStr != "" {
+		sub.ListJoinedDate, err = time.Parse("2006-01-02 15:04:05", sub.ListJoinedDateStr)
+		if err != nil {
+			return err
+		}
+		sub.ListJoinedDateStr = sub.ListJoinedDate.Format(time.RFC3339)
+	}
+	return nil
 }
 
 // GetSubscriber gets a subscriber's details.
@@ -110,9 +166,28 @@ type Subscriber struct {
 // http://www.campaignmonitor.com/api/subscribers/#getting_a_subscribers_details
 // for more information.
 func (c *APIClient) GetSubscriber(listID string, email string) (*Subscriber, error) {
-	u := fmt.Sprintf("subscribers/%s.json?email=%s", listID, email)
+	return c.GetSubscriberContext(context.Background(), listID, email)
+}
+
+// GetSubscriberContext is like GetSubscriber but uses ctx for the API request.
+func (c *APIClient) GetSubscriberContext(ctx context.Context, listID string, email string) (*Subscriber, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	return c.getSubscriber(ctx, listID, email, false)
+}
+
+// getSubscriber gets the details of the subscriber with the given normalized
+// email address, including their ConsentToTrack if trackingPreference is set.
+func (c *APIClient) getSubscriber(ctx context.Context, listID string, email string, trackingPreference bool) (*Subscriber, error) {
+	v := url.Values{"email": {email}}
+	if trackingPreference {
+		v.Set("includetrackingpreference", "true")
+	}
+	u := fmt.Sprintf("subscribers/%s.json?%s", listID, v.Encode())
 
-	req, err := c.NewRequest("GET", u, nil)
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -120,45 +195,117 @@ func (c *APIClient) GetSubscriber(listID string, email string) (*Subscriber, err
 	var sub Subscriber
 	err = c.Do(req, &sub)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) != 0 {
-			return nil, err
-		}
+		return nil, err
 	}
 
-	// Parse createsend API date format. (See Subscriber.DateStr field comment.)
-	sub.Date, err = time.Parse("2006-01-02 15:04:05", sub.DateStr)
+	err = sub.parseDates()
 	if err != nil {
 		return nil, err
 	}
-	sub.DateStr = sub.Date.Format(time.RFC3339)
 
 	return &sub, nil
 }
 
+// HistoryEvent is the kind of a SubscriberAction.
+type HistoryEvent string
+
+const (
+	EventOpen        HistoryEvent = "Open"
+	EventClick       HistoryEvent = "Click"
+	EventBounce      HistoryEvent = "Bounce"
+	EventUnsubscribe HistoryEvent = "Unsubscribe"
+)
+
+// SubscriberHistoryItem is a campaign or autoresponder email sent to a
+// subscriber, together with what the subscriber did with it.
+type SubscriberHistoryItem struct {
+	ID      string
+	Type    string // "Campaign" or "Autoresponder"
+	Name    string
+	Actions []*SubscriberAction
+}
+
+// SubscriberAction is a single action of a subscriber on an email. Detail
+// holds the URL of a click or the reason of a bounce.
+type SubscriberAction struct {
+	Event     HistoryEvent
+	Date      time.Time `json:"-"`
+	IPAddress string
+	Detail    string
+
+	// DateStr holds the API's date, like Subscriber.DateStr.
+	DateStr string `json:"Date"`
+}
+
+// SubscriberHistory returns the campaigns and autoresponders sent to a
+// subscriber and their actions on each, most recent first.
+//
+// See https://www.campaignmonitor.com/api/subscribers/#getting_subscriber_history
+// for more information.
+func (c *APIClient) SubscriberHistory(listID string, email string) ([]*SubscriberHistoryItem, error) {
+	return c.SubscriberHistoryContext(context.Background(), listID, email)
+}
+
+// SubscriberHistoryContext is like SubscriberHistory but uses ctx for the API request.
+func (c *APIClient) SubscriberHistoryContext(ctx context.Context, listID string, email string) ([]*SubscriberHistoryItem, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("subscribers/%s/history.json?%s", listID, url.Values{"email": {email}}.Encode())
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []*SubscriberHistoryItem
+	err = c.Do(req, &history)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range history {
+		for _, a := range item.Actions {
+			if a.DateStr == "" {
+				continue
+			}
+			a.Date, err = time.Parse("2006-01-02 15:04:05", a.DateStr)
+			if err != nil {
+				return nil, err
+			}
+			a.DateStr = a.Date.Format(time.RFC3339)
+		}
+	}
+
+	return history, nil
+}
+
 // Unsubscribe changes the status of a subscriber from Active to Unsubscribed.
 //
 // See
 // http://www.campaignmonitor.com/api/subscribers/#unsubscribing_a_subscriber
 // for more information.
 func (c *APIClient) Unsubscribe(listID string, email string) error {
+	return c.UnsubscribeContext(context.Background(), listID, email)
+}
+
+// UnsubscribeContext is like Unsubscribe but uses ctx for the API request.
+func (c *APIClient) UnsubscribeContext(ctx context.Context, listID string, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
 	u := fmt.Sprintf("subscribers/%s/unsubscribe.json", listID)
 
-	req, err := c.NewRequest("POST", u, struct{ EmailAddress string }{email})
+	req, err := c.NewRequestWithContext(ctx, "POST", u, struct{ EmailAddress string }{email})
 	if err != nil {
 		return err
 	}
 
 	err = c.Do(req, nil)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return err
@@ -170,9 +317,18 @@ func (c *APIClient) Unsubscribe(listID string, email string) error {
 // https://www.campaignmonitor.com/api/subscribers/#deleting_a_subscriber
 // for more information.
 func (c *APIClient) DeleteSubscriber(listID string, email string) error {
-	u := fmt.Sprintf("subscribers/%s.json?email=%s", listID, email)
+	return c.DeleteSubscriberContext(context.Background(), listID, email)
+}
+
+// DeleteSubscriberContext is like DeleteSubscriber but uses ctx for the API request.
+func (c *APIClient) DeleteSubscriberContext(ctx context.Context, listID string, email string) error {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("subscribers/%s.json?%s", listID, url.Values{"email": {email}}.Encode())
 
-	req, err := c.NewRequest("DELETE", u, struct{ EmailAddress string }{email})
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, struct{ EmailAddress string }{email})
 	if err != nil {
 		return err
 	}
@@ -180,13 +336,7 @@ func (c *APIClient) DeleteSubscriber(listID string, email string) error {
 	err = c.Do(req, nil)
 
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return nil
-		} else {
-			return err
-		}
+		return err
 	}
 
 	return nil
@@ -209,30 +359,57 @@ type ImportSubscribers struct {
 	RestartSubscriptionBasedAutoresponders bool `json:",omitempty"`
 }
 
+// ImportResult is the outcome of an import.
+//
+// See
+// https://www.campaignmonitor.com/api/subscribers/#importing_many_subscribers
+// for more information.
+type ImportResult struct {
+	FailureDetails              []ImportFailure
+	TotalUniqueEmailsSubmitted  int
+	TotalExistingSubscribers    int
+	TotalNewSubscribers         int
+	DuplicateEmailsInSubmission []string
+}
+
+// ImportFailure describes a subscriber that could not be imported.
+type ImportFailure struct {
+	EmailAddress string
+	Code         int
+	Message      string
+}
+
 // Importing many subscribes
 //
+// If some of the subscribers could not be imported, the API responds with
+// error code CodeImportFailures; ImportSubscribers then returns both the
+// result, whose FailureDetails list the failed subscribers, and the *Error.
+//
 // See
 // https://www.campaignmonitor.com/api/subscribers/#importing_many_subscribers
 // for more information.
-func (c *APIClient) ImportSubscribers(listID string, importSubscribers ImportSubscribers) (interface{}, error) {
+func (c *APIClient) ImportSubscribers(listID string, importSubscribers ImportSubscribers) (*ImportResult, error) {
+	return c.ImportSubscribersContext(context.Background(), listID, importSubscribers)
+}
+
+// ImportSubscribersContext is like ImportSubscribers but uses ctx for the API request.
+func (c *APIClient) ImportSubscribersContext(ctx context.Context, listID string, importSubscribers ImportSubscribers) (*ImportResult, error) {
 	u := fmt.Sprintf("subscribers/%s/import.json", listID)
 
-	req, err := c.NewRequest("POST", u, importSubscribers)
+	req, err := c.NewRequestWithContext(ctx, "POST", u, importSubscribers)
 	if err != nil {
 		return nil, err
 	}
 
-	var v interface{}
-	err = c.Do(req, &v)
+	var result ImportResult
+	err = c.Do(req, &result)
 	if err != nil {
-		// EOF is not a real error according to the Internet
-		// See: https://medium.com/@simonfrey/go-as-in-golang-standard-net-http-config-will-break-your-production-environment-1360871cb72b
-		if strings.Compare("EOF", err.Error()) == 0 {
-			return v, nil
-		} else {
-			return v, err
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.Code == CodeImportFailures && apiErr.DecodeResultData(&result) == nil {
+			return &result, err
 		}
+		return nil, err
 	}
 
-	return v, err
+	return &result, nil
 }
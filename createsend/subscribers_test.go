@@ -33,7 +33,7 @@ func TestUpdateSubscriber(t *testing.T) {
 
 	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
-		testQuerystring(t, r, "email=alice@example.com")
+		testQuerystring(t, r, "email=alice%40example.com")
 		_, _ = fmt.Fprint(w, "OK")
 	})
 
@@ -53,7 +53,7 @@ func TestGetSubscriber(t *testing.T) {
 
 	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		testQuerystring(t, r, "email=alice@example.com")
+		testQuerystring(t, r, "email=alice%40example.com")
 		_, _ = fmt.Fprint(w, `{"EmailAddress":"alice@example.com","Name":"alice","Date":"2010-10-25 10:28:00"}`)
 	})
 
@@ -72,6 +72,25 @@ func TestGetSubscriber(t *testing.T) {
 	}
 }
 
+func TestGetSubscriber_noDate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"EmailAddress":"alice@example.com","Name":"alice"}`)
+	})
+
+	want := Subscriber{EmailAddress: "alice@example.com", Name: "alice"}
+	sub, err := client.GetSubscriber("12CD", "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetSubscriber returned error: %v", err)
+	}
+	if !reflect.DeepEqual(*sub, want) {
+		t.Errorf("GetSubscriber returned %+v, want %+v", *sub, want)
+	}
+}
+
 func TestGetSubscriber_NotInList(t *testing.T) {
 	setup()
 	defer teardown()
@@ -81,11 +100,20 @@ func TestGetSubscriber_NotInList(t *testing.T) {
 		_, _ = fmt.Fprint(w, `{"Code": 203, "Message": "Subscriber not in list"}`)
 	})
 
-	want := &Error{Code: 203, Message: "Subscriber not in list"}
+	want := &Error{
+		Code:       203,
+		Message:    "Subscriber not in list",
+		StatusCode: http.StatusBadRequest,
+		Method:     "GET",
+		URL:        server.URL + "/subscribers/12CD.json?email=alice%40example.com",
+	}
 	sub, err := client.GetSubscriber("12CD", "alice@example.com")
 	if !reflect.DeepEqual(err, want) {
 		t.Errorf("GetSubscriber returned error %+v, want %+v", err, want)
 	}
+	if !IsSubscriberNotInList(err) {
+		t.Errorf("IsSubscriberNotInList(%v) = false, want true", err)
+	}
 	if sub != nil {
 		t.Errorf("GetSubscriber returned non-nil subscriber %+v", sub)
 	}
@@ -119,10 +147,15 @@ func TestImportSubscribers(t *testing.T) {
 
 	im := ImportSubscribers{Subscribers: []ImportSubscriber{s1, s2}}
 
-	_, err := client.ImportSubscribers("12CD", im)
+	result, err := client.ImportSubscribers("12CD", im)
 	if err != nil {
 		t.Errorf("ImportSubcribers returned error: %v", err)
 	}
+
+	want := &ImportResult{FailureDetails: []ImportFailure{}, TotalUniqueEmailsSubmitted: 3, TotalNewSubscribers: 2, DuplicateEmailsInSubmission: []string{}}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("ImportSubscribers returned %+v, want %+v", result, want)
+	}
 }
 
 func TestImportSubscribersFailed(t *testing.T) {
@@ -155,10 +188,20 @@ func TestImportSubscribersFailed(t *testing.T) {
 
 	im := ImportSubscribers{Subscribers: []ImportSubscriber{s1, s2}}
 
-	_, err := client.ImportSubscribers("12CD", im)
+	result, err := client.ImportSubscribers("12CD", im)
 	if err == nil {
 		t.Error("ImportSubcribers returned no error")
 	}
+
+	want := &ImportResult{
+		TotalUniqueEmailsSubmitted:  3,
+		TotalExistingSubscribers:    2,
+		DuplicateEmailsInSubmission: []string{},
+		FailureDetails:              []ImportFailure{{EmailAddress: "example+1@example", Code: 1, Message: "Invalid Email Address"}},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("ImportSubscribers returned %+v, want %+v", result, want)
+	}
 }
 
 func TestDeleteSubscriber(t *testing.T) {
@@ -189,3 +232,56 @@ func TestDeleteSubscriberFail(t *testing.T) {
 		t.Error("DeleteSubscriber did not return an error")
 	}
 }
+
+func TestSubscriberHistory(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/subscribers/12CD/history.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("email"); got != "alice+news@example.com" {
+			t.Errorf("email = %q, want %q", got, "alice+news@example.com")
+		}
+		_, _ = fmt.Fprint(w, `[{
+			"ID": "fc0ce710",
+			"Type": "Campaign",
+			"Name": "Campaign One",
+			"Actions": [
+				{"Event": "Click", "Date": "2010-10-12 13:18:00", "IPAddress": "192.0.2.1", "Detail": "http://example.com/"},
+				{"Event": "Open", "Date": "2010-10-12 13:16:00", "IPAddress": "192.0.2.1", "Detail": ""}
+			]
+		}, {
+			"ID": "a8c07b2d",
+			"Type": "Autoresponder",
+			"Name": "Welcome",
+			"Actions": [
+				{"Event": "Bounce", "Date": "2010-10-11 09:00:00", "IPAddress": "", "Detail": "Soft Bounce - Mailbox Full"}
+			]
+		}]`)
+	})
+
+	history, err := client.SubscriberHistory("12CD", "alice+news@example.com")
+	if err != nil {
+		t.Fatalf("SubscriberHistory returned error: %v", err)
+	}
+
+	want := []*SubscriberHistoryItem{{
+		ID:   "fc0ce710",
+		Type: "Campaign",
+		Name: "Campaign One",
+		Actions: []*SubscriberAction{
+			{Event: EventClick, Date: time.Date(2010, 10, 12, 13, 18, 0, 0, time.UTC), DateStr: "2010-10-12T13:18:00Z", IPAddress: "192.0.2.1", Detail: "http://example.com/"},
+			{Event: EventOpen, Date: time.Date(2010, 10, 12, 13, 16, 0, 0, time.UTC), DateStr: "2010-10-12T13:16:00Z", IPAddress: "192.0.2.1"},
+		},
+	}, {
+		ID:   "a8c07b2d",
+		Type: "Autoresponder",
+		Name: "Welcome",
+		Actions: []*SubscriberAction{
+			{Event: EventBounce, Date: time.Date(2010, 10, 11, 9, 0, 0, 0, time.UTC), DateStr: "2010-10-11T09:00:00Z", Detail: "Soft Bounce - Mailbox Full"},
+		},
+	}}
+	if !reflect.DeepEqual(history, want) {
+		t.Errorf("SubscriberHistory returned %+v, want %+v", history, want)
+	}
+}
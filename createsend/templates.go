@@ -0,0 +1,129 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// TemplateCreate represents the parameters needed to create or update a
+// template. The HTML page and the optional zip file of images are fetched by
+// Campaign Monitor from the given URLs.
+//
+// See https://www.campaignmonitor.com/api/templates/#creating_a_template for
+// more information.
+type TemplateCreate struct {
+	Name        string `json:"Name"`
+	HtmlPageURL string `json:"HtmlPageURL"`
+	ZipFileURL  string `json:"ZipFileURL,omitempty"`
+}
+
+// TemplateCreate creates a new template for a client and returns its ID.
+//
+// See https://www.campaignmonitor.com/api/templates/#creating_a_template for
+// more information.
+func (c *APIClient) TemplateCreate(clientID string, tmpl *TemplateCreate) (string, error) {
+	return c.TemplateCreateContext(context.Background(), clientID, tmpl)
+}
+
+// TemplateCreateContext is like TemplateCreate but uses ctx for the API request.
+func (c *APIClient) TemplateCreateContext(ctx context.Context, clientID string, tmpl *TemplateCreate) (string, error) {
+	u := fmt.Sprintf("templates/%s.json", clientID)
+
+	req, err := c.NewRequestWithContext(ctx, "POST", u, tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var templateID string
+	err = c.Do(req, &templateID)
+	if err != nil {
+		return "", err
+	}
+	return templateID, nil
+}
+
+// TemplateCopy copies a template to a client, which may be another client
+// than the one it belongs to, and returns the ID of the copy.
+//
+// The API has no endpoint to copy a template, and does not return the
+// content of existing templates, so the copy is created again from src: the
+// HtmlPageURL and ZipFileURL the original was created or last updated from.
+// It is an error if src.HtmlPageURL is empty.
+func (c *APIClient) TemplateCopy(clientID string, src TemplateCreate) (string, error) {
+	return c.TemplateCopyContext(context.Background(), clientID, src)
+}
+
+// TemplateCopyContext is like TemplateCopy but uses ctx for the API request.
+func (c *APIClient) TemplateCopyContext(ctx context.Context, clientID string, src TemplateCreate) (string, error) {
+	if src.HtmlPageURL == "" {
+		return "", errors.New("createsend: TemplateCopy requires an HtmlPageURL")
+	}
+	return c.TemplateCreateContext(ctx, clientID, &src)
+}
+
+// TemplateDetails returns the details of a template.
+//
+// See https://www.campaignmonitor.com/api/templates/#getting_a_template for
+// more information.
+func (c *APIClient) TemplateDetails(templateID string) (*Template, error) {
+	return c.TemplateDetailsContext(context.Background(), templateID)
+}
+
+// TemplateDetailsContext is like TemplateDetails but uses ctx for the API
+// request.
+func (c *APIClient) TemplateDetailsContext(ctx context.Context, templateID string) (*Template, error) {
+	u := fmt.Sprintf("templates/%s.json", templateID)
+
+	req, err := c.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tmpl Template
+	err = c.Do(req, &tmpl)
+	if err != nil {
+		return nil, err
+	}
+	return &tmpl, nil
+}
+
+// TemplateUpdate replaces the name and content of a template.
+//
+// See https://www.campaignmonitor.com/api/templates/#updating_a_template for
+// more information.
+func (c *APIClient) TemplateUpdate(templateID string, tmpl *TemplateCreate) error {
+	return c.TemplateUpdateContext(context.Background(), templateID, tmpl)
+}
+
+// TemplateUpdateContext is like TemplateUpdate but uses ctx for the API request.
+func (c *APIClient) TemplateUpdateContext(ctx context.Context, templateID string, tmpl *TemplateCreate) error {
+	u := fmt.Sprintf("templates/%s.json", templateID)
+
+	req, err := c.NewRequestWithContext(ctx, "PUT", u, tmpl)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
+
+// TemplateDelete deletes a template.
+//
+// See https://www.campaignmonitor.com/api/templates/#deleting_a_template for
+// more information.
+func (c *APIClient) TemplateDelete(templateID string) error {
+	return c.TemplateDeleteContext(context.Background(), templateID)
+}
+
+// TemplateDeleteContext is like TemplateDelete but uses ctx for the API request.
+func (c *APIClient) TemplateDeleteContext(ctx context.Context, templateID string) error {
+	u := fmt.Sprintf("templates/%s.json", templateID)
+
+	req, err := c.NewRequestWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, nil)
+}
@@ -0,0 +1,126 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTemplateCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tmpl := &TemplateCreate{Name: "Welcome", HtmlPageURL: "http://example.com/welcome.html", ZipFileURL: "http://example.com/images.zip"}
+	mux.HandleFunc("/templates/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got TemplateCreate
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got != *tmpl {
+			t.Errorf("Request body = %+v, want %+v", got, *tmpl)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `"5cac213cf061dd4e008de5a82b7a3621"`)
+	})
+
+	id, err := client.TemplateCreate("12ab", tmpl)
+	if err != nil {
+		t.Errorf("TemplateCreate returned error: %v", err)
+	}
+	if id != "5cac213cf061dd4e008de5a82b7a3621" {
+		t.Errorf("TemplateCreate returned %q", id)
+	}
+}
+
+func TestTemplateCopy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	src := TemplateCreate{Name: "Welcome", HtmlPageURL: "http://example.com/welcome.html", ZipFileURL: "http://example.com/images.zip"}
+	mux.HandleFunc("/templates/34cd.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got TemplateCreate
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got != src {
+			t.Errorf("Request body = %+v, want %+v", got, src)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `"6dbd324dg172ee5f119ef6b93c8b4732"`)
+	})
+
+	id, err := client.TemplateCopy("34cd", src)
+	if err != nil {
+		t.Errorf("TemplateCopy returned error: %v", err)
+	}
+	if id != "6dbd324dg172ee5f119ef6b93c8b4732" {
+		t.Errorf("TemplateCopy returned %q", id)
+	}
+
+	if _, err := client.TemplateCopy("34cd", TemplateCreate{Name: "Welcome"}); err == nil {
+		t.Error("TemplateCopy without an HtmlPageURL returned no error")
+	}
+}
+
+func TestTemplateDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/5cac.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"TemplateID": "5cac",
+			"Name": "Welcome",
+			"PreviewURL": "http://preview.createsend1.com/templates/publicpreview/01AF532CD8889B33?d=r",
+			"ScreenshotURL": "http://preview.createsend1.com/ts/r/14/833/263/14833263.jpg?0318092600"
+		}`)
+	})
+
+	tmpl, err := client.TemplateDetails("5cac")
+	if err != nil {
+		t.Errorf("TemplateDetails returned error: %v", err)
+	}
+
+	want := &Template{
+		TemplateID:    "5cac",
+		Name:          "Welcome",
+		PreviewURL:    "http://preview.createsend1.com/templates/publicpreview/01AF532CD8889B33?d=r",
+		ScreenshotURL: "http://preview.createsend1.com/ts/r/14/833/263/14833263.jpg?0318092600",
+	}
+	if !reflect.DeepEqual(tmpl, want) {
+		t.Errorf("TemplateDetails returned %+v, want %+v", tmpl, want)
+	}
+}
+
+func TestTemplateUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/5cac.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var got map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if _, ok := got["ZipFileURL"]; ok {
+			t.Errorf("Request body contains an empty ZipFileURL: %+v", got)
+		}
+	})
+
+	err := client.TemplateUpdate("5cac", &TemplateCreate{Name: "Welcome v2", HtmlPageURL: "http://example.com/welcome.html"})
+	if err != nil {
+		t.Errorf("TemplateUpdate returned error: %v", err)
+	}
+}
+
+func TestTemplateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/5cac.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	err := client.TemplateDelete("5cac")
+	if err != nil {
+		t.Errorf("TemplateDelete returned error: %v", err)
+	}
+}
@@ -0,0 +1,499 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSmartEmails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/smartEmail", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("status"); got != "active" {
+			t.Errorf("status = %q, want %q", got, "active")
+		}
+		if got := r.URL.Query().Get("clientID"); got != "12ab" {
+			t.Errorf("clientID = %q, want %q", got, "12ab")
+		}
+		_, _ = fmt.Fprint(w, `[{"ID": "bb4a6ebb", "Name": "Welcome email", "CreatedAt": "2015-08-14T13:58:36.0Z", "Status": "Active"}]`)
+	})
+
+	emails, err := client.SmartEmails(&SmartEmailsOptions{Status: SmartEmailActive, ClientID: "12ab"})
+	if err != nil {
+		t.Errorf("SmartEmails returned error: %v", err)
+	}
+
+	want := []*SmartEmail{{ID: "bb4a6ebb", Name: "Welcome email", CreatedAt: "2015-08-14T13:58:36.0Z", Status: "Active"}}
+	if !reflect.DeepEqual(emails, want) {
+		t.Errorf("SmartEmails returned %+v, want %+v", emails, want)
+	}
+}
+
+func TestSmartEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/smartEmail/bb4a6ebb", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{
+			"SmartEmailID": "bb4a6ebb",
+			"CreatedAt": "2015-08-14T13:58:36.0Z",
+			"Status": "Active",
+			"Name": "Welcome email",
+			"Properties": {
+				"From": "support@example.com",
+				"ReplyTo": "support@example.com",
+				"Subject": "Thanks for signing up",
+				"Content": {
+					"Html": "<p>Hi {{name}}</p>",
+					"Text": "Hi {{name}}",
+					"EmailVariables": ["name"],
+					"InlineCss": true
+				},
+				"TextPreviewUrl": "https://example.com/text",
+				"HtmlPreviewUrl": "https://example.com/html"
+			},
+			"AddRecipientsToList": "62eaaa0338245ca68e5e93daa6f591e9"
+		}`)
+	})
+
+	details, err := client.SmartEmail("bb4a6ebb")
+	if err != nil {
+		t.Errorf("SmartEmail returned error: %v", err)
+	}
+
+	want := &SmartEmailDetails{
+		SmartEmailID: "bb4a6ebb",
+		Name:         "Welcome email",
+		CreatedAt:    "2015-08-14T13:58:36.0Z",
+		Status:       "Active",
+		Properties: SmartEmailProperties{
+			From:    "support@example.com",
+			ReplyTo: "support@example.com",
+			Subject: "Thanks for signing up",
+			Content: SmartEmailContent{
+				Html:           "<p>Hi {{name}}</p>",
+				Text:           "Hi {{name}}",
+				EmailVariables: []string{"name"},
+				InlineCss:      true,
+			},
+			TextPreviewURL: "https://example.com/text",
+			HtmlPreviewURL: "https://example.com/html",
+		},
+		AddRecipientsToList: "62eaaa0338245ca68e5e93daa6f591e9",
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("SmartEmail returned %+v, want %+v", details, want)
+	}
+}
+
+func TestSendSmartEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type welcome struct {
+		Name string `json:"name"`
+	}
+
+	mux.HandleFunc("/transactional/smartEmail/bb4a6ebb/send", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var got map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		want := map[string]interface{}{
+			"To":                  []interface{}{"Alice <alice@example.com>"},
+			"Attachments":         []interface{}{map[string]interface{}{"Type": "text/plain", "Name": "hello.txt", "Content": "aGVsbG8="}},
+			"Data":                map[string]interface{}{"name": "Alice"},
+			"AddRecipientsToList": false,
+			"ConsentToTrack":      "Yes",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %+v, want %+v", got, want)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, `[{"Status": "Accepted", "MessageID": "0cfe150d", "Recipient": "Alice <alice@example.com>"}]`)
+	})
+
+	sent, err := client.SendSmartEmail("bb4a6ebb", &SmartEmailMessage{
+		To:             []string{"Alice <alice@example.com>"},
+		Attachments:    []Attachment{{Type: "text/plain", Name: "hello.txt", Content: []byte("hello")}},
+		Data:           welcome{Name: "Alice"},
+		ConsentToTrack: ConsentYes,
+	})
+	if err != nil {
+		t.Errorf("SendSmartEmail returned error: %v", err)
+	}
+
+	want := []*SentMessage{{MessageID: "0cfe150d", Recipient: "Alice <alice@example.com>", Status: "Accepted"}}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("SendSmartEmail returned %+v, want %+v", sent, want)
+	}
+}
+
+func TestSendSmartEmail_noConsent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/smartEmail/bb4a6ebb/send", func(w http.ResponseWriter, r *http.Request) {
+		var got map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got["ConsentToTrack"] != "Unchanged" {
+			t.Errorf("ConsentToTrack = %v, want Unchanged", got["ConsentToTrack"])
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	msg := &SmartEmailMessage{To: []string{"alice@example.com"}}
+	if _, err := client.SendSmartEmail("bb4a6ebb", msg); err != nil {
+		t.Errorf("SendSmartEmail returned error: %v", err)
+	}
+	if msg.ConsentToTrack != "" {
+		t.Errorf("SendSmartEmail changed the message's ConsentToTrack to %q", msg.ConsentToTrack)
+	}
+}
+
+func TestSendClassicEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	msg := &ClassicEmailMessage{
+		Subject:        "Your receipt",
+		From:           "shop@example.com",
+		To:             []string{"alice@example.com", "bob@example.com"},
+		Html:           "<p>Thanks!</p>",
+		TrackOpens:     true,
+		Group:          "Receipts",
+		ConsentToTrack: ConsentNo,
+	}
+
+	mux.HandleFunc("/transactional/classicEmail/send", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.URL.Query().Get("clientID"); got != "12ab" {
+			t.Errorf("clientID = %q, want %q", got, "12ab")
+		}
+		var got ClassicEmailMessage
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if !reflect.DeepEqual(&got, msg) {
+			t.Errorf("Request body = %+v, want %+v", got, msg)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, `[
+			{"Status": "Accepted", "MessageID": "a1", "Recipient": "alice@example.com"},
+			{"Status": "Accepted", "MessageID": "b2", "Recipient": "bob@example.com"}
+		]`)
+	})
+
+	sent, err := client.SendClassicEmail("12ab", msg)
+	if err != nil {
+		t.Errorf("SendClassicEmail returned error: %v", err)
+	}
+
+	want := []*SentMessage{
+		{MessageID: "a1", Recipient: "alice@example.com", Status: "Accepted"},
+		{MessageID: "b2", Recipient: "bob@example.com", Status: "Accepted"},
+	}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("SendClassicEmail returned %+v, want %+v", sent, want)
+	}
+}
+
+func TestSendClassicEmail_noConsent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/classicEmail/send", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("SendClassicEmail sent a request without ConsentToTrack")
+	})
+
+	_, err := client.SendClassicEmail("12ab", &ClassicEmailMessage{Subject: "Hi", From: "shop@example.com", To: []string{"alice@example.com"}})
+	if err == nil {
+		t.Error("SendClassicEmail returned no error without ConsentToTrack")
+	}
+}
+
+func TestClassicEmailGroups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/classicEmail/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "" {
+			t.Errorf("Query = %q, want none", got)
+		}
+		_, _ = fmt.Fprint(w, `[{"Group": "Password Reset", "CreatedAt": "2015-08-14T13:58:36.0Z"}]`)
+	})
+
+	groups, err := client.ClassicEmailGroups("")
+	if err != nil {
+		t.Errorf("ClassicEmailGroups returned error: %v", err)
+	}
+
+	want := []*ClassicEmailGroup{{Group: "Password Reset", CreatedAt: "2015-08-14T13:58:36.0Z"}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("ClassicEmailGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestMessageTimeline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := "count=10&group=Password+Reset&sentAfterID=aa&status=delivered"
+		if got := r.URL.RawQuery; got != want {
+			t.Errorf("Query = %q, want %q", got, want)
+		}
+		_, _ = fmt.Fprint(w, `[{
+			"MessageID": "ddc697c7",
+			"Status": "Delivered",
+			"SentAt": "2014-01-15T16:09:19-05:00",
+			"Recipient": "alice@example.com",
+			"From": "support@example.com",
+			"Subject": "Reset your password",
+			"Group": "Password Reset",
+			"TotalOpens": 1,
+			"TotalClicks": 2,
+			"CanBeResent": true
+		}]`)
+	})
+
+	messages, err := client.MessageTimeline(&MessageTimelineOptions{Status: "delivered", Count: 10, Group: "Password Reset", SentAfterID: "aa"})
+	if err != nil {
+		t.Errorf("MessageTimeline returned error: %v", err)
+	}
+
+	want := []*Message{{
+		MessageID:   "ddc697c7",
+		Status:      "Delivered",
+		SentAt:      "2014-01-15T16:09:19-05:00",
+		Recipient:   "alice@example.com",
+		From:        "support@example.com",
+		Subject:     "Reset your password",
+		Group:       "Password Reset",
+		TotalOpens:  1,
+		TotalClicks: 2,
+		CanBeResent: true,
+	}}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("MessageTimeline returned %+v, want %+v", messages, want)
+	}
+}
+
+func TestMessageTimelineAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pages := map[string]string{
+		"":   `[{"MessageID": "m5"}, {"MessageID": "m4"}]`,
+		"m4": `[{"MessageID": "m3"}, {"MessageID": "m2"}]`,
+		"m2": `[{"MessageID": "m1"}]`,
+	}
+	requests := 0
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.URL.Query().Get("smartEmailID"); got != "bb4a" {
+			t.Errorf("smartEmailID = %q, want %q", got, "bb4a")
+		}
+		page, ok := pages[r.URL.Query().Get("sentBeforeID")]
+		if !ok {
+			t.Errorf("Unexpected sentBeforeID %q", r.URL.Query().Get("sentBeforeID"))
+		}
+		_, _ = fmt.Fprint(w, page)
+	})
+
+	it := client.MessageTimelineAll(&MessageTimelineOptions{Count: 2, SmartEmailID: "bb4a"})
+	defer it.Close()
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Message().MessageID)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("MessageIterator returned error: %v", err)
+	}
+
+	if want := []string{"m5", "m4", "m3", "m2", "m1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("MessageIterator returned %v, want %v", ids, want)
+	}
+	if requests != 3 {
+		t.Errorf("Server received %d requests, want 3", requests)
+	}
+}
+
+func TestMessageTimelineAll_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sentBeforeID") == "" {
+			_, _ = fmt.Fprint(w, `[{"MessageID": "m2"}]`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{"Code": 1, "Message": "Invalid sentBeforeID"}`)
+	})
+
+	it := client.MessageTimelineAll(&MessageTimelineOptions{Count: 1})
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("MessageIterator returned %d messages, want 1", n)
+	}
+	if it.Err() == nil {
+		t.Error("MessageIterator returned no error")
+	}
+}
+
+func TestMessageDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages/ddc697c7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("statistics"); got != "true" {
+			t.Errorf("statistics = %q, want %q", got, "true")
+		}
+		_, _ = fmt.Fprint(w, `{
+			"MessageID": "ddc697c7",
+			"Status": "Delivered",
+			"SentAt": "2014-01-15T16:09:19-05:00",
+			"SmartEmailID": "bb4a6ebb",
+			"CanBeResent": true,
+			"Recipient": "alice@example.com",
+			"Message": {
+				"From": "support@example.com",
+				"Subject": "Thanks for signing up",
+				"To": ["alice@example.com"],
+				"Body": {"Html": "<p>Hi</p>", "Text": "Hi"},
+				"Attachments": [{"Name": "hello.txt", "Type": "text/plain"}],
+				"Data": {"name": "Alice"}
+			},
+			"TotalOpens": 1,
+			"TotalClicks": 1,
+			"Opens": [{
+				"EmailAddress": "alice@example.com",
+				"Date": "2014-01-15T16:10:00-05:00",
+				"IPAddress": "192.0.2.1",
+				"Geolocation": {"Latitude": -33.8683, "Longitude": 151.2086, "City": "Sydney", "Region": "New South Wales", "CountryCode": "AU", "CountryName": "Australia"},
+				"MailClient": "Gmail"
+			}],
+			"Clicks": [{
+				"EmailAddress": "alice@example.com",
+				"Date": "2014-01-15T16:11:00-05:00",
+				"URL": "https://example.com/",
+				"IPAddress": "192.0.2.1",
+				"Geolocation": {"City": "Sydney", "CountryCode": "AU"},
+				"MailClient": "Gmail"
+			}]
+		}`)
+	})
+
+	details, err := client.MessageDetails("ddc697c7", true)
+	if err != nil {
+		t.Fatalf("MessageDetails returned error: %v", err)
+	}
+
+	want := &MessageDetails{
+		MessageID:    "ddc697c7",
+		Status:       "Delivered",
+		SentAt:       "2014-01-15T16:09:19-05:00",
+		SmartEmailID: "bb4a6ebb",
+		CanBeResent:  true,
+		Recipient:    "alice@example.com",
+		Message: MessageContent{
+			From:        "support@example.com",
+			Subject:     "Thanks for signing up",
+			To:          []string{"alice@example.com"},
+			Body:        MessageBody{Html: "<p>Hi</p>", Text: "Hi"},
+			Attachments: []MessageAttachment{{Name: "hello.txt", Type: "text/plain"}},
+			Data:        map[string]interface{}{"name": "Alice"},
+		},
+		TotalOpens:  1,
+		TotalClicks: 1,
+		Opens: []*MessageOpen{{
+			EmailAddress: "alice@example.com",
+			Date:         "2014-01-15T16:10:00-05:00",
+			IPAddress:    "192.0.2.1",
+			Geolocation:  Geolocation{Latitude: -33.8683, Longitude: 151.2086, City: "Sydney", Region: "New South Wales", CountryCode: "AU", CountryName: "Australia"},
+			MailClient:   "Gmail",
+		}},
+		Clicks: []*MessageClick{{
+			EmailAddress: "alice@example.com",
+			Date:         "2014-01-15T16:11:00-05:00",
+			URL:          "https://example.com/",
+			IPAddress:    "192.0.2.1",
+			Geolocation:  Geolocation{City: "Sydney", CountryCode: "AU"},
+			MailClient:   "Gmail",
+		}},
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("MessageDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestResendMessage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages/ddc697c7/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		_, _ = fmt.Fprint(w, `[{"Status": "Accepted", "MessageID": "f3a2", "Recipient": "alice@example.com"}]`)
+	})
+
+	sent, err := client.ResendMessage("ddc697c7")
+	if err != nil {
+		t.Errorf("ResendMessage returned error: %v", err)
+	}
+
+	want := []*SentMessage{{MessageID: "f3a2", Recipient: "alice@example.com", Status: "Accepted"}}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("ResendMessage returned %+v, want %+v", sent, want)
+	}
+}
+
+func TestTransactionalStats(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/statistics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := "from=2015-01-01&smartEmailID=bb4a&timezone=utc&to=2015-06-30"
+		if got := r.URL.RawQuery; got != want {
+			t.Errorf("Query = %q, want %q", got, want)
+		}
+		_, _ = fmt.Fprint(w, `{
+			"Query": {"TimeZone": "UTC", "From": "2015-01-01", "To": "2015-06-30", "SmartEmailID": "bb4a"},
+			"Sent": 1000, "Bounces": 20, "Delivered": 980, "Opened": 300, "Clicked": 130
+		}`)
+	})
+
+	stats, err := client.TransactionalStats(&TransactionalStatsOptions{
+		SmartEmailID: "bb4a",
+		From:         time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2015, 6, 30, 0, 0, 0, 0, time.UTC),
+		Timezone:     TimezoneUTC,
+	})
+	if err != nil {
+		t.Errorf("TransactionalStats returned error: %v", err)
+	}
+
+	want := &TransactionalStatistics{
+		Query:     TransactionalStatisticsQuery{TimeZone: "UTC", From: "2015-01-01", To: "2015-06-30", SmartEmailID: "bb4a"},
+		Sent:      1000,
+		Bounces:   20,
+		Delivered: 980,
+		Opened:    300,
+		Clicked:   130,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("TransactionalStats returned %+v, want %+v", stats, want)
+	}
+}
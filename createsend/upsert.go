@@ -0,0 +1,215 @@
+package createsend
+
+import (
+	"context"
+	"sort"
+)
+
+// ResubscribePolicy decides whether UpsertSubscriber resubscribes a
+// subscriber who is unsubscribed, bounced or deleted.
+type ResubscribePolicy int
+
+const (
+	// ResubscribeNever updates the details of inactive subscribers but
+	// leaves them inactive.
+	ResubscribeNever ResubscribePolicy = iota
+
+	// ResubscribeWithConsent resubscribes inactive subscribers only if
+	// UpsertOptions.Consent is set.
+	ResubscribeWithConsent
+
+	// ResubscribeAlways resubscribes inactive subscribers, including those
+	// whose address bounced.
+	ResubscribeAlways
+)
+
+// UpsertOptions configures UpsertSubscriber.
+type UpsertOptions struct {
+	// Resubscribe is the policy for inactive subscribers. The zero value is
+	// ResubscribeNever.
+	Resubscribe ResubscribePolicy
+
+	// Consent records that the subscriber explicitly asked to be subscribed,
+	// for example by submitting a signup form. It allows
+	// ResubscribeWithConsent to resubscribe them.
+	Consent bool
+
+	// RestartSubscriptionBasedAutoresponders restarts subscription based
+	// autoresponders for resubscribed subscribers.
+	RestartSubscriptionBasedAutoresponders bool
+
+	// Schema holds the list's custom fields, used to compare values by
+	// their DataType. If nil, UpsertSubscriber fetches them with
+	// ListCustomFields when custom fields of an existing subscriber need
+	// comparing. Set it to avoid that request when upserting many
+	// subscribers.
+	Schema *CustomFieldSchema
+}
+
+// UpsertAction is what UpsertSubscriber did.
+type UpsertAction string
+
+const (
+	UpsertAdded     UpsertAction = "added"
+	UpsertUpdated   UpsertAction = "updated"
+	UpsertUnchanged UpsertAction = "unchanged"
+)
+
+// UpsertResult reports what UpsertSubscriber did.
+type UpsertResult struct {
+	Action UpsertAction
+
+	// PreviousState is the State of the subscriber before the upsert, or
+	// empty if they were added.
+	PreviousState string
+
+	// Resubscribed reports whether an inactive subscriber was resubscribed.
+	Resubscribed bool
+
+	// Changed lists what was sent in an update: "Name", "ConsentToTrack"
+	// and the keys of changed custom fields, as given in the NewSubscriber.
+	Changed []string
+}
+
+// UpsertSubscriber adds a subscriber to a list, or updates them if they are
+// already in it, without having to know which is needed.
+//
+// For an existing subscriber, only what differs from their current details
+// is sent: Name if it is set and different, custom fields whose values
+// differ (all values of a multi-valued field if any differs), and
+// ConsentToTrack if it is set and different, otherwise ConsentUnchanged. No
+// update is sent if nothing differs and the subscriber is not resubscribed.
+// An empty ConsentToTrack of a new subscriber is sent as ConsentUnchanged. The Resubscribe and
+// RestartSubscriptionBasedAutoresponders fields of sub are ignored in favor
+// of opt, which may be nil.
+func (c *APIClient) UpsertSubscriber(listID string, sub NewSubscriber, opt *UpsertOptions) (*UpsertResult, error) {
+	return c.UpsertSubscriberContext(context.Background(), listID, sub, opt)
+}
+
+// UpsertSubscriberContext is like UpsertSubscriber but uses ctx for the API requests.
+func (c *APIClient) UpsertSubscriberContext(ctx context.Context, listID string, sub NewSubscriber, opt *UpsertOptions) (*UpsertResult, error) {
+	if opt == nil {
+		opt = &UpsertOptions{}
+	}
+	email, err := NormalizeEmail(sub.EmailAddress)
+	if err != nil {
+		return nil, err
+	}
+	resubscribe := opt.Resubscribe == ResubscribeAlways || (opt.Resubscribe == ResubscribeWithConsent && opt.Consent)
+
+	cur, err := c.getSubscriber(ctx, listID, email, true)
+	if IsSubscriberNotInList(err) {
+		sub.EmailAddress = email
+		if sub.ConsentToTrack == "" {
+			sub.ConsentToTrack = string(ConsentUnchanged)
+		}
+		sub.Resubscribe = resubscribe
+		sub.RestartSubscriptionBasedAutoresponders = resubscribe && opt.RestartSubscriptionBasedAutoresponders
+		if err := c.AddSubscriberContext(ctx, listID, sub); err != nil {
+			return nil, err
+		}
+		return &UpsertResult{Action: UpsertAdded}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UpsertResult{Action: UpsertUnchanged, PreviousState: cur.State}
+	// API v3.2 requires ConsentToTrack in every update; Unchanged keeps the
+	// subscriber's current preference.
+	upd := NewSubscriber{EmailAddress: email, ConsentToTrack: string(ConsentUnchanged)}
+	if sub.Name != "" && sub.Name != cur.Name {
+		upd.Name = sub.Name
+		res.Changed = append(res.Changed, "Name")
+	}
+	if sub.ConsentToTrack != "" && sub.ConsentToTrack != string(ConsentUnchanged) && sub.ConsentToTrack != string(cur.ConsentToTrack) {
+		upd.ConsentToTrack = sub.ConsentToTrack
+		res.Changed = append(res.Changed, "ConsentToTrack")
+	}
+	if len(sub.CustomFields) > 0 {
+		schema := opt.Schema
+		if schema == nil {
+			defs, err := c.ListCustomFieldsContext(ctx, listID)
+			if err != nil {
+				return nil, err
+			}
+			schema = NewCustomFieldSchema(defs)
+		}
+		var keys []string
+		upd.CustomFields, keys = diffCustomFields(schema, cur.CustomFields, sub.CustomFields)
+		res.Changed = append(res.Changed, keys...)
+	}
+
+	switch cur.State {
+	case "Unsubscribed", "Bounced", "Deleted":
+		res.Resubscribed = resubscribe
+	}
+	if len(res.Changed) == 0 && !res.Resubscribed {
+		return res, nil
+	}
+
+	upd.Resubscribe = res.Resubscribed
+	upd.RestartSubscriptionBasedAutoresponders = res.Resubscribed && opt.RestartSubscriptionBasedAutoresponders
+	if err := c.UpdateSubscriberContext(ctx, listID, email, upd); err != nil {
+		return nil, err
+	}
+	res.Action = UpsertUpdated
+	return res, nil
+}
+
+// diffCustomFields returns the fields of want that change the values of cur,
+// and the keys of the changed fields in the order they first appear in want.
+// Values are compared in the canonical form of their field's DataType in
+// schema, and the values of multi-valued fields as sets.
+func diffCustomFields(schema *CustomFieldSchema, cur, want []CustomField) ([]CustomField, []string) {
+	values := func(fields []CustomField) map[string][]string {
+		m := make(map[string][]string)
+		for _, f := range fields {
+			k := CustomFieldKey(f.Key)
+			if f.Clear {
+				m[k] = m[k][:0:0]
+				continue
+			}
+			d, _ := schema.Definition(k)
+			m[k] = append(m[k], comparableCustomFieldValue(d, f.Value))
+		}
+		for _, vs := range m {
+			sort.Strings(vs)
+		}
+		return m
+	}
+	curValues, wantValues := values(cur), values(want)
+
+	changed := make(map[string]bool)
+	var keys []string
+	for _, f := range want {
+		k := CustomFieldKey(f.Key)
+		if _, ok := changed[k]; ok {
+			continue
+		}
+		changed[k] = !equalStrings(curValues[k], wantValues[k])
+		if changed[k] {
+			keys = append(keys, f.Key)
+		}
+	}
+
+	var fields []CustomField
+	for _, f := range want {
+		if changed[CustomFieldKey(f.Key)] {
+			fields = append(fields, f)
+		}
+	}
+	return fields, keys
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
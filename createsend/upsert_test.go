@@ -0,0 +1,183 @@
+package createsend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// upsertCustomFields are the custom fields of list 12CD in the upsert tests.
+var upsertCustomFields = []CustomFieldDefinition{
+	{FieldName: "Age", Key: "[Age]", DataType: Number},
+	{FieldName: "Joined", Key: "[Joined]", DataType: Date},
+	{FieldName: "Interests", Key: "[Interests]", DataType: MultiSelectMany, FieldOptions: []string{"Go", "Zig"}},
+	{FieldName: "Plan", Key: "[Plan]", DataType: Text},
+}
+
+// upsertServer registers a handler for list 12CD that serves current as the
+// subscriber's details, or a "not in list" error if it is empty, and records
+// the subscribers added or updated. It also serves the list's custom fields,
+// upsertCustomFields.
+func upsertServer(t *testing.T, current string) (added, updated *[]NewSubscriber) {
+	added, updated = new([]NewSubscriber), new([]NewSubscriber)
+	mux.HandleFunc("/lists/12CD/customfields.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_ = json.NewEncoder(w).Encode(upsertCustomFields)
+	})
+	mux.HandleFunc("/subscribers/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testQuerystring(t, r, "email=alice%2Bnews%40example.com&includetrackingpreference=true")
+			if current == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = fmt.Fprint(w, `{"Code": 203, "Message": "Subscriber not in list"}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, current)
+			return
+		case "POST":
+			var sub NewSubscriber
+			_ = json.NewDecoder(r.Body).Decode(&sub)
+			*added = append(*added, sub)
+			_, _ = fmt.Fprint(w, `"alice+news@example.com"`)
+		case "PUT":
+			testQuerystring(t, r, "email=alice%2Bnews%40example.com")
+			var sub NewSubscriber
+			_ = json.NewDecoder(r.Body).Decode(&sub)
+			*updated = append(*updated, sub)
+		default:
+			t.Errorf("Request method: %v", r.Method)
+		}
+	})
+	return added, updated
+}
+
+func TestUpsertSubscriber_add(t *testing.T) {
+	setup()
+	defer teardown()
+	added, updated := upsertServer(t, "")
+
+	sub := NewSubscriber{EmailAddress: "alice+news@Example.com", Name: "Alice", Resubscribe: true}
+	res, err := client.UpsertSubscriber("12CD", sub, nil)
+	if err != nil {
+		t.Fatalf("UpsertSubscriber returned error: %v", err)
+	}
+	if want := (&UpsertResult{Action: UpsertAdded}); !reflect.DeepEqual(res, want) {
+		t.Errorf("UpsertSubscriber returned %+v, want %+v", res, want)
+	}
+	want := []NewSubscriber{{EmailAddress: "alice+news@example.com", Name: "Alice", ConsentToTrack: "Unchanged"}}
+	if !reflect.DeepEqual(*added, want) || len(*updated) != 0 {
+		t.Errorf("UpsertSubscriber added %+v and updated %+v, want %+v added", *added, *updated, want)
+	}
+}
+
+func TestUpsertSubscriber_update(t *testing.T) {
+	setup()
+	defer teardown()
+	_, updated := upsertServer(t, `{
+		"EmailAddress": "alice+news@example.com",
+		"Name": "Alice",
+		"State": "Active",
+		"ConsentToTrack": "Yes",
+		"CustomFields": [
+			{"Key": "Age", "Value": "30.0"},
+			{"Key": "Joined", "Value": "2010-10-25"},
+			{"Key": "Interests", "Value": "Go"},
+			{"Key": "Interests", "Value": "Zig"},
+			{"Key": "Plan", "Value": "Free"}
+		]
+	}`)
+
+	sub := NewSubscriber{
+		EmailAddress: "alice+news@example.com",
+		Name:         "Alice",
+		CustomFields: []CustomField{
+			{Key: "[Age]", Value: 30},
+			{Key: "[Joined]", Value: "2010/10/25"},
+			{Key: "[Interests]", Value: "Zig"},
+			{Key: "[Interests]", Value: "Go"},
+			{Key: "[Plan]", Value: "Pro"},
+			ClearCustomField("[Website]"),
+		},
+		ConsentToTrack: "Yes",
+	}
+	res, err := client.UpsertSubscriber("12CD", sub, nil)
+	if err != nil {
+		t.Fatalf("UpsertSubscriber returned error: %v", err)
+	}
+	wantRes := &UpsertResult{Action: UpsertUpdated, PreviousState: "Active", Changed: []string{"[Plan]"}}
+	if !reflect.DeepEqual(res, wantRes) {
+		t.Errorf("UpsertSubscriber returned %+v, want %+v", res, wantRes)
+	}
+	want := []NewSubscriber{{
+		EmailAddress:   "alice+news@example.com",
+		CustomFields:   []CustomField{{Key: "[Plan]", Value: "Pro"}},
+		ConsentToTrack: "Unchanged",
+	}}
+	if !reflect.DeepEqual(*updated, want) {
+		t.Errorf("UpsertSubscriber sent %+v, want %+v", *updated, want)
+	}
+
+	sub.CustomFields = sub.CustomFields[:4]
+	sub.CustomFields[1].Value = time.Date(2010, 10, 25, 0, 0, 0, 0, time.UTC)
+	res, err = client.UpsertSubscriber("12CD", sub, &UpsertOptions{Schema: NewCustomFieldSchema(upsertCustomFields)})
+	if err != nil {
+		t.Fatalf("UpsertSubscriber returned error: %v", err)
+	}
+	wantRes = &UpsertResult{Action: UpsertUnchanged, PreviousState: "Active"}
+	if !reflect.DeepEqual(res, wantRes) || len(*updated) != 1 {
+		t.Errorf("UpsertSubscriber returned %+v and sent %d updates, want %+v and no new update", res, len(*updated), wantRes)
+	}
+}
+
+func TestUpsertSubscriber_resubscribe(t *testing.T) {
+	tests := []struct {
+		opt          *UpsertOptions
+		resubscribed bool
+	}{
+		{nil, false},
+		{&UpsertOptions{Resubscribe: ResubscribeWithConsent}, false},
+		{&UpsertOptions{Resubscribe: ResubscribeWithConsent, Consent: true}, true},
+		{&UpsertOptions{Resubscribe: ResubscribeAlways, RestartSubscriptionBasedAutoresponders: true}, true},
+	}
+	for _, tt := range tests {
+		setup()
+		_, updated := upsertServer(t, `{"EmailAddress": "alice+news@example.com", "Name": "Alice", "State": "Unsubscribed"}`)
+
+		sub := NewSubscriber{EmailAddress: "alice+news@example.com", Name: "Alice"}
+		res, err := client.UpsertSubscriber("12CD", sub, tt.opt)
+		teardown()
+		if err != nil {
+			t.Fatalf("UpsertSubscriber(%+v) returned error: %v", tt.opt, err)
+		}
+		if res.Resubscribed != tt.resubscribed || res.PreviousState != "Unsubscribed" {
+			t.Errorf("UpsertSubscriber(%+v) returned %+v, want Resubscribed = %v", tt.opt, res, tt.resubscribed)
+		}
+
+		var want []NewSubscriber
+		if tt.resubscribed {
+			want = []NewSubscriber{{
+				EmailAddress:                           "alice+news@example.com",
+				ConsentToTrack:                         "Unchanged",
+				Resubscribe:                            true,
+				RestartSubscriptionBasedAutoresponders: tt.opt.RestartSubscriptionBasedAutoresponders,
+			}}
+		}
+		if !reflect.DeepEqual(*updated, want) {
+			t.Errorf("UpsertSubscriber(%+v) sent %+v, want %+v", tt.opt, *updated, want)
+		}
+	}
+}
+
+func TestUpsertSubscriber_invalidEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpsertSubscriber("12CD", NewSubscriber{EmailAddress: "alice"}, nil)
+	if _, ok := err.(*EmailError); !ok {
+		t.Errorf("UpsertSubscriber returned %v, want an *EmailError", err)
+	}
+}
@@ -0,0 +1,161 @@
+package subscriberio
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Joule-CMA/createsend-go/createsend"
+)
+
+// CSVReader reads subscribers from a CSV file with a header row. Columns not
+// in the mapping are ignored.
+type CSVReader struct {
+	// Schema, if set, validates custom field values and converts them to
+	// the form the API expects, such as dates in YYYY/MM/DD format.
+	Schema *createsend.CustomFieldSchema
+
+	// OnInvalid, if set, is called with rows that cannot be converted to a
+	// subscriber, which are then skipped. Otherwise Next returns their
+	// *RowError; reading may continue after it.
+	OnInvalid func(err *RowError)
+
+	r     *csv.Reader
+	m     *Mapping
+	index []int // record index of each column of m
+	row   int
+	err   error // sticky error reading the header
+}
+
+// NewCSVReader returns a CSVReader reading from r according to m.
+func NewCSVReader(r io.Reader, m *Mapping) *CSVReader {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	cr.FieldsPerRecord = -1
+	return &CSVReader{r: cr, m: m}
+}
+
+// Next returns the next subscriber, or io.EOF at the end of the file.
+func (r *CSVReader) Next() (createsend.ImportSubscriber, error) {
+	if r.index == nil {
+		if r.err == nil {
+			r.err = r.readHeader()
+		}
+		if r.err != nil {
+			return createsend.ImportSubscriber{}, r.err
+		}
+	}
+
+	c := converter{m: r.m, schema: r.Schema}
+	sep := r.m.separator()
+	for {
+		record, err := r.r.Read()
+		if err != nil {
+			return createsend.ImportSubscriber{}, err
+		}
+		r.row++
+
+		sub, err := c.subscriber(func(i int, col Column) ([]interface{}, error) {
+			j := r.index[i]
+			if j >= len(record) || record[j] == "" {
+				return nil, nil
+			}
+			if !col.Multi {
+				return []interface{}{record[j]}, nil
+			}
+			var vs []interface{}
+			for _, v := range strings.Split(record[j], sep) {
+				if v = strings.TrimSpace(v); v != "" {
+					vs = append(vs, v)
+				}
+			}
+			return vs, nil
+		})
+		if err == nil {
+			return sub, nil
+		}
+
+		rowErr := &RowError{Row: r.row, Err: err}
+		if r.OnInvalid == nil {
+			return createsend.ImportSubscriber{}, rowErr
+		}
+		r.OnInvalid(rowErr)
+	}
+}
+
+func (r *CSVReader) readHeader() error {
+	if err := r.m.Check(); err != nil {
+		return err
+	}
+	header, err := r.r.Read()
+	if err == io.EOF {
+		return fmt.Errorf("subscriberio: missing CSV header")
+	}
+	if err != nil {
+		return err
+	}
+	r.row++
+
+	pos := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff") // byte order mark
+		}
+		pos[h] = i
+	}
+	index := make([]int, len(r.m.Columns))
+	for i, col := range r.m.Columns {
+		j, ok := pos[col.Header]
+		if !ok {
+			return fmt.Errorf("subscriberio: CSV file has no column %q", col.Header)
+		}
+		index[i] = j
+	}
+	r.index = index
+	return nil
+}
+
+// CSVWriter writes subscribers to a CSV file, starting with a header row of
+// the mapping's headers.
+type CSVWriter struct {
+	w             *csv.Writer
+	m             *Mapping
+	record        []string
+	headerWritten bool
+}
+
+// NewCSVWriter returns a CSVWriter writing to w according to m.
+func NewCSVWriter(w io.Writer, m *Mapping) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), m: m, record: make([]string, len(m.Columns))}
+}
+
+// Write writes a subscriber. Values of multi-valued columns are joined with
+// the mapping's Separator.
+func (w *CSVWriter) Write(sub *createsend.Subscriber) error {
+	if !w.headerWritten {
+		if err := w.m.Check(); err != nil {
+			return err
+		}
+		for i, col := range w.m.Columns {
+			w.record[i] = col.Header
+		}
+		if err := w.w.Write(w.record); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	for i, vs := range subscriberValues(w.m, sub) {
+		w.record[i] = strings.Join(vs, w.m.separator())
+	}
+	return w.w.Write(w.record)
+}
+
+// Flush writes any buffered data to the underlying writer and returns the
+// first error that occurred while writing, if any.
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
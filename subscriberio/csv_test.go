@@ -0,0 +1,148 @@
+package subscriberio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Joule-CMA/createsend-go/createsend"
+)
+
+// readAll reads all subscribers from src.
+func readAll(t *testing.T, src createsend.ImportSource) []createsend.ImportSubscriber {
+	t.Helper()
+	var subs []createsend.ImportSubscriber
+	for {
+		sub, err := src.Next()
+		if err == io.EOF {
+			return subs
+		}
+		if err != nil {
+			t.Fatalf("Next returned error: %v", err)
+		}
+		subs = append(subs, sub)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	in := "\ufeffEmailAddress,Name,Ignored,Joined,Interests\n" +
+		"alice@example.com,Alice,x,2020-01-02,Go | Zig\n" +
+		"bob@example.com,,,,\n"
+
+	m := DefaultMapping(testDefs)
+	m.Columns = append(m.Columns[:3], m.Columns[4:]...) // no Age column
+
+	r := NewCSVReader(strings.NewReader(in), m)
+	r.Schema = createsend.NewCustomFieldSchema(testDefs)
+
+	got := readAll(t, r)
+	want := []createsend.ImportSubscriber{
+		{
+			EmailAddress: "alice@example.com",
+			Name:         "Alice",
+			CustomFields: []createsend.CustomField{
+				{Key: "[Joined]", Value: "2020/01/02"},
+				{Key: "[Interests]", Value: "Go"},
+				{Key: "[Interests]", Value: "Zig"},
+			},
+		},
+		{EmailAddress: "bob@example.com"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CSVReader returned %+v, want %+v", got, want)
+	}
+}
+
+func TestCSVReader_invalid(t *testing.T) {
+	in := "EmailAddress,Age\n" +
+		"alice@example.com,thirty\n" +
+		",31\n" +
+		"bob@example.com,32\n"
+	m := &Mapping{Columns: []Column{{Header: "EmailAddress", Field: "EmailAddress"}, {Header: "Age", Field: "[Age]"}}}
+
+	r := NewCSVReader(strings.NewReader(in), m)
+	r.Schema = createsend.NewCustomFieldSchema(testDefs)
+	var rows []int
+	r.OnInvalid = func(err *RowError) { rows = append(rows, err.Row) }
+
+	got := readAll(t, r)
+	want := []createsend.ImportSubscriber{
+		{EmailAddress: "bob@example.com", CustomFields: []createsend.CustomField{{Key: "[Age]", Value: "32"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CSVReader returned %+v, want %+v", got, want)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("OnInvalid called for rows %v, want %v", rows, want)
+	}
+
+	r = NewCSVReader(strings.NewReader(in), m)
+	r.Schema = createsend.NewCustomFieldSchema(testDefs)
+	_, err := r.Next()
+	var rowErr *RowError
+	var cfErr *createsend.CustomFieldError
+	if !errors.As(err, &rowErr) || rowErr.Row != 2 || !errors.As(err, &cfErr) {
+		t.Fatalf("Next returned %v, want a *RowError for row 2 wrapping a *CustomFieldError", err)
+	}
+	if _, err := r.Next(); !errors.As(err, &rowErr) || rowErr.Row != 3 {
+		t.Fatalf("Next returned %v, want a *RowError for row 3", err)
+	}
+	if sub, err := r.Next(); err != nil || sub.EmailAddress != "bob@example.com" {
+		t.Errorf("Next returned %+v, %v, want bob@example.com", sub, err)
+	}
+}
+
+func TestCSVReader_missingColumn(t *testing.T) {
+	r := NewCSVReader(strings.NewReader("EmailAddress\nalice@example.com\nName\n"), DefaultMapping(nil))
+	for i := 0; i < 2; i++ {
+		if sub, err := r.Next(); err == nil || err == io.EOF {
+			t.Errorf("Next call %d returned %+v, %v for a file without a Name column, want a missing column error", i+1, sub, err)
+		}
+	}
+
+	r = NewCSVReader(strings.NewReader(""), DefaultMapping(nil))
+	if _, err := r.Next(); err == nil || err == io.EOF {
+		t.Errorf("Next returned %v for an empty file, want a missing header error", err)
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	m := DefaultMapping(testDefs)
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf, m)
+	subs := []createsend.Subscriber{
+		{
+			EmailAddress: "alice@example.com",
+			Name:         "Alice, A.",
+			CustomFields: []createsend.CustomField{
+				{Key: "[Age]", Value: "30"},
+				{Key: "[Interests]", Value: "Go"},
+				{Key: "[Interests]", Value: "Zig"},
+			},
+		},
+		{EmailAddress: "bob@example.com"},
+	}
+	for i := range subs {
+		if err := w.Write(&subs[i]); err != nil {
+			t.Fatalf("Write returned error: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush returned error: %v", err)
+	}
+
+	want := "EmailAddress,Name,Joined,Age,Interests\n" +
+		"alice@example.com,\"Alice, A.\",,30,Go|Zig\n" +
+		"bob@example.com,,,,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("CSVWriter wrote %q, want %q", got, want)
+	}
+
+	got := readAll(t, NewCSVReader(&buf, m))
+	if len(got) != 2 || got[0].Name != "Alice, A." || len(got[0].CustomFields) != 3 {
+		t.Errorf("CSVReader read back %+v", got)
+	}
+}
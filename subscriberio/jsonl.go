@@ -0,0 +1,141 @@
+package subscriberio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Joule-CMA/createsend-go/createsend"
+)
+
+// JSONLReader reads subscribers from a JSON Lines file, one JSON object per
+// line. Keys not in the mapping are ignored, as are null values.
+type JSONLReader struct {
+	// Schema and OnInvalid have the same meaning as for CSVReader.
+	Schema    *createsend.CustomFieldSchema
+	OnInvalid func(err *RowError)
+
+	s   *bufio.Scanner
+	m   *Mapping
+	row int
+	err error
+}
+
+// maxJSONLLine is the maximum length of a line JSONLReader accepts.
+const maxJSONLLine = 1 << 20
+
+// NewJSONLReader returns a JSONLReader reading from r according to m.
+func NewJSONLReader(r io.Reader, m *Mapping) *JSONLReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxJSONLLine)
+	return &JSONLReader{s: s, m: m}
+}
+
+// Next returns the next subscriber, or io.EOF at the end of the file. Blank
+// lines are skipped.
+func (r *JSONLReader) Next() (createsend.ImportSubscriber, error) {
+	if r.row == 0 && r.err == nil {
+		r.err = r.m.Check()
+	}
+	if r.err != nil {
+		return createsend.ImportSubscriber{}, r.err
+	}
+
+	c := converter{m: r.m, schema: r.Schema}
+	for r.s.Scan() {
+		r.row++
+		line := r.s.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		sub, err := r.convert(&c, line)
+		if err == nil {
+			return sub, nil
+		}
+
+		rowErr := &RowError{Row: r.row, Err: err}
+		if r.OnInvalid == nil {
+			return createsend.ImportSubscriber{}, rowErr
+		}
+		r.OnInvalid(rowErr)
+	}
+
+	r.err = r.s.Err()
+	if r.err == nil {
+		r.err = io.EOF
+	}
+	return createsend.ImportSubscriber{}, r.err
+}
+
+func (r *JSONLReader) convert(c *converter, line []byte) (createsend.ImportSubscriber, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(line, &obj); err != nil {
+		return createsend.ImportSubscriber{}, err
+	}
+
+	return c.subscriber(func(i int, col Column) ([]interface{}, error) {
+		raw, ok := obj[col.Header]
+		if !ok {
+			return nil, nil
+		}
+		var v interface{}
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		switch v := v.(type) {
+		case nil:
+			return nil, nil
+		case string:
+			if v == "" {
+				return nil, nil
+			}
+			return []interface{}{v}, nil
+		case json.Number, bool:
+			return []interface{}{v}, nil
+		case []interface{}:
+			if !col.Multi {
+				return nil, fmt.Errorf("array in single-valued column")
+			}
+			return v, nil
+		}
+		return nil, fmt.Errorf("unsupported value %s", raw)
+	})
+}
+
+// JSONLWriter writes subscribers to a JSON Lines file, as objects keyed by the
+// mapping's headers. Values of multi-valued columns are written as arrays,
+// and empty values are left out.
+type JSONLWriter struct {
+	enc *json.Encoder
+	m   *Mapping
+}
+
+// NewJSONLWriter returns a JSONLWriter writing to w according to m.
+func NewJSONLWriter(w io.Writer, m *Mapping) *JSONLWriter {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return &JSONLWriter{enc: enc, m: m}
+}
+
+// Write writes a subscriber.
+func (w *JSONLWriter) Write(sub *createsend.Subscriber) error {
+	obj := make(map[string]interface{}, len(w.m.Columns))
+	for i, vs := range subscriberValues(w.m, sub) {
+		col := w.m.Columns[i]
+		switch {
+		case col.Multi:
+			if len(vs) > 0 {
+				obj[col.Header] = vs
+			}
+		case len(vs) > 0 && vs[0] != "":
+			obj[col.Header] = vs[0]
+		}
+	}
+	return w.enc.Encode(obj)
+}
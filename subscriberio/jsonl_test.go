@@ -0,0 +1,99 @@
+package subscriberio
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Joule-CMA/createsend-go/createsend"
+)
+
+func TestJSONLReader(t *testing.T) {
+	in := `{"EmailAddress": "alice@example.com", "Name": "Alice", "Age": 30, "Interests": ["Go", "Zig"], "Other": {}}
+
+{"EmailAddress": "bob@example.com", "Name": null, "Joined": "2020-01-02"}
+`
+	r := NewJSONLReader(strings.NewReader(in), DefaultMapping(testDefs))
+	r.Schema = createsend.NewCustomFieldSchema(testDefs)
+
+	got := readAll(t, r)
+	want := []createsend.ImportSubscriber{
+		{
+			EmailAddress: "alice@example.com",
+			Name:         "Alice",
+			CustomFields: []createsend.CustomField{
+				{Key: "[Age]", Value: json.Number("30")},
+				{Key: "[Interests]", Value: "Go"},
+				{Key: "[Interests]", Value: "Zig"},
+			},
+		},
+		{
+			EmailAddress: "bob@example.com",
+			CustomFields: []createsend.CustomField{{Key: "[Joined]", Value: "2020/01/02"}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("JSONLReader returned %+v, want %+v", got, want)
+	}
+}
+
+func TestJSONLReader_invalid(t *testing.T) {
+	in := `{"EmailAddress": "alice@example.com", "Age": [1, 2]}
+not json
+{"EmailAddress": "bob@example.com"}
+`
+	r := NewJSONLReader(strings.NewReader(in), DefaultMapping(testDefs))
+	_, err := r.Next()
+	var rowErr *RowError
+	if !errors.As(err, &rowErr) || rowErr.Row != 1 {
+		t.Fatalf("Next returned %v, want a *RowError for row 1", err)
+	}
+
+	var rows []int
+	r.OnInvalid = func(err *RowError) { rows = append(rows, err.Row) }
+	got := readAll(t, r)
+	if len(got) != 1 || got[0].EmailAddress != "bob@example.com" {
+		t.Errorf("JSONLReader returned %+v, want bob@example.com only", got)
+	}
+	if want := []int{2}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("OnInvalid called for rows %v, want %v", rows, want)
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	m := DefaultMapping(testDefs)
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf, m)
+	subs := []createsend.Subscriber{
+		{
+			EmailAddress: "alice@example.com",
+			Name:         "Alice & Co",
+			CustomFields: []createsend.CustomField{
+				{Key: "[Age]", Value: "30"},
+				{Key: "[Interests]", Value: "Go"},
+				{Key: "[Interests]", Value: "Zig"},
+			},
+		},
+		{EmailAddress: "bob@example.com"},
+	}
+	for i := range subs {
+		if err := w.Write(&subs[i]); err != nil {
+			t.Fatalf("Write returned error: %v", err)
+		}
+	}
+
+	want := `{"Age":"30","EmailAddress":"alice@example.com","Interests":["Go","Zig"],"Name":"Alice & Co"}
+{"EmailAddress":"bob@example.com"}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("JSONLWriter wrote %q, want %q", got, want)
+	}
+
+	got := readAll(t, NewJSONLReader(&buf, m))
+	if len(got) != 2 || got[0].Name != "Alice & Co" || len(got[0].CustomFields) != 3 {
+		t.Errorf("JSONLReader read back %+v", got)
+	}
+}
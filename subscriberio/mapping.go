@@ -0,0 +1,212 @@
+// Package subscriberio reads subscribers to import from CSV and JSON Lines
+// files, and writes subscribers to such files, one record at a time so that
+// files of any size can be processed.
+//
+// The readers implement createsend.ImportSource and can be passed directly to
+// APIClient.BulkImport, or read in batches for APIClient.ImportSubscribers
+// with createsend.ReadImportBatch.
+package subscriberio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Joule-CMA/createsend-go/createsend"
+)
+
+// DefaultSeparator separates the values of multi-valued columns in CSV
+// cells.
+const DefaultSeparator = "|"
+
+// Column maps a CSV column or JSON Lines object key to a subscriber field.
+type Column struct {
+	// Header is the CSV header or JSON key of the column.
+	Header string
+
+	// Field is "EmailAddress", "Name", or a custom field key in brackets,
+	// such as "[Plan]", as in the "createsend" struct tags.
+	Field string
+
+	// Multi marks a column holding several values of a MultiSelectMany
+	// custom field: separated by the mapping's Separator in CSV cells, or
+	// as an array in JSON Lines.
+	Multi bool
+}
+
+// isCustom reports whether c maps to a custom field.
+func (c Column) isCustom() bool {
+	return strings.HasPrefix(c.Field, "[") && strings.HasSuffix(c.Field, "]")
+}
+
+// Mapping maps columns to subscriber fields. Exactly one column must map to
+// EmailAddress.
+type Mapping struct {
+	Columns []Column
+
+	// Separator defaults to DefaultSeparator.
+	Separator string
+}
+
+// DefaultMapping returns a mapping of the columns "EmailAddress" and "Name"
+// and a column per custom field, headed by the field's name.
+func DefaultMapping(defs []createsend.CustomFieldDefinition) *Mapping {
+	m := &Mapping{Columns: []Column{
+		{Header: "EmailAddress", Field: "EmailAddress"},
+		{Header: "Name", Field: "Name"},
+	}}
+	for _, d := range defs {
+		m.Columns = append(m.Columns, Column{Header: d.FieldName, Field: d.Key, Multi: d.DataType == createsend.MultiSelectMany})
+	}
+	return m
+}
+
+func (m *Mapping) separator() string {
+	if m.Separator == "" {
+		return DefaultSeparator
+	}
+	return m.Separator
+}
+
+// Check checks that m is well formed: headers are unique, exactly one column
+// maps to EmailAddress, and every field is EmailAddress, Name or a custom
+// field key in brackets.
+func (m *Mapping) Check() error {
+	headers := make(map[string]bool, len(m.Columns))
+	emails := 0
+	for _, c := range m.Columns {
+		if headers[c.Header] {
+			return fmt.Errorf("subscriberio: duplicate column %q", c.Header)
+		}
+		headers[c.Header] = true
+
+		switch {
+		case c.Field == "EmailAddress":
+			emails++
+		case c.Field == "Name", c.isCustom():
+		default:
+			return fmt.Errorf("subscriberio: column %q: field %q is neither EmailAddress, Name nor a custom field key in brackets", c.Header, c.Field)
+		}
+		if c.Multi && !c.isCustom() {
+			return fmt.Errorf("subscriberio: column %q: only custom fields may have multiple values", c.Header)
+		}
+	}
+	if emails != 1 {
+		return errors.New("subscriberio: exactly one column must map to EmailAddress")
+	}
+	return nil
+}
+
+// Validate is like Check, but also checks the custom fields of m against a
+// list's custom fields, as returned by ListCustomFields. It returns a
+// *createsend.CustomFieldError for a column mapped to a field the list does
+// not have, or marked Multi for a field that is not MultiSelectMany.
+func (m *Mapping) Validate(defs []createsend.CustomFieldDefinition) error {
+	if err := m.Check(); err != nil {
+		return err
+	}
+	schema := createsend.NewCustomFieldSchema(defs)
+	for _, c := range m.Columns {
+		if !c.isCustom() {
+			continue
+		}
+		d, ok := schema.Definition(c.Field)
+		if !ok {
+			return &createsend.CustomFieldError{Key: c.Field, Reason: fmt.Sprintf("column %q maps to a field the list does not have", c.Header)}
+		}
+		if c.Multi && d.DataType != createsend.MultiSelectMany {
+			return &createsend.CustomFieldError{Key: c.Field, DataType: d.DataType, Reason: fmt.Sprintf("column %q has multiple values but the field has a single value", c.Header)}
+		}
+	}
+	return nil
+}
+
+// RowError reports a row that could not be converted to a subscriber. Row
+// counts from 1; in CSV files, the header is row 1.
+type RowError struct {
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("subscriberio: row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// converter converts rows to subscribers according to a mapping.
+type converter struct {
+	m      *Mapping
+	schema *createsend.CustomFieldSchema
+}
+
+// subscriber builds the subscriber for a row. values returns the values of
+// the column with the given index, or none if the cell is empty.
+func (c *converter) subscriber(values func(i int, col Column) ([]interface{}, error)) (createsend.ImportSubscriber, error) {
+	var sub createsend.ImportSubscriber
+	for i, col := range c.m.Columns {
+		vs, err := values(i, col)
+		if err != nil {
+			return createsend.ImportSubscriber{}, fmt.Errorf("column %q: %v", col.Header, err)
+		}
+		if len(vs) == 0 {
+			continue
+		}
+
+		switch col.Field {
+		case "EmailAddress", "Name":
+			s, ok := vs[0].(string)
+			if !ok {
+				return createsend.ImportSubscriber{}, fmt.Errorf("column %q: %s is not a string", col.Header, col.Field)
+			}
+			if col.Field == "EmailAddress" {
+				sub.EmailAddress = s
+			} else {
+				sub.Name = s
+			}
+			continue
+		}
+
+		if c.schema == nil {
+			for _, v := range vs {
+				sub.CustomFields = append(sub.CustomFields, createsend.CustomField{Key: col.Field, Value: v})
+			}
+			continue
+		}
+		for _, v := range vs {
+			fields, err := c.schema.Encode(col.Field, v)
+			if err != nil {
+				return createsend.ImportSubscriber{}, err
+			}
+			sub.CustomFields = append(sub.CustomFields, fields...)
+		}
+	}
+	if sub.EmailAddress == "" {
+		return createsend.ImportSubscriber{}, errors.New("no email address")
+	}
+	return sub, nil
+}
+
+// subscriberValues returns the values of sub for each column of m.
+func subscriberValues(m *Mapping, sub *createsend.Subscriber) [][]string {
+	custom := make(map[string][]string, len(sub.CustomFields))
+	for _, cf := range sub.CustomFields {
+		k := createsend.CustomFieldKey(cf.Key)
+		custom[k] = append(custom[k], fmt.Sprint(cf.Value))
+	}
+
+	values := make([][]string, len(m.Columns))
+	for i, col := range m.Columns {
+		switch col.Field {
+		case "EmailAddress":
+			values[i] = []string{sub.EmailAddress}
+		case "Name":
+			values[i] = []string{sub.Name}
+		default:
+			values[i] = custom[createsend.CustomFieldKey(col.Field)]
+		}
+	}
+	return values
+}
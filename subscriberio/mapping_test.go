@@ -0,0 +1,55 @@
+package subscriberio
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Joule-CMA/createsend-go/createsend"
+)
+
+var testDefs = []createsend.CustomFieldDefinition{
+	{FieldName: "Joined", Key: "[Joined]", DataType: createsend.Date},
+	{FieldName: "Age", Key: "[Age]", DataType: createsend.Number},
+	{FieldName: "Interests", Key: "[Interests]", DataType: createsend.MultiSelectMany, FieldOptions: []string{"Go", "Rust", "Zig"}},
+}
+
+func TestMapping_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []Column
+		ok      bool
+	}{
+		{"ok", []Column{{Header: "email", Field: "EmailAddress"}, {Header: "name", Field: "Name"}, {Header: "age", Field: "[Age]"}}, true},
+		{"no email", []Column{{Header: "name", Field: "Name"}}, false},
+		{"two emails", []Column{{Header: "a", Field: "EmailAddress"}, {Header: "b", Field: "EmailAddress"}}, false},
+		{"duplicate header", []Column{{Header: "a", Field: "EmailAddress"}, {Header: "a", Field: "Name"}}, false},
+		{"bad field", []Column{{Header: "a", Field: "EmailAddress"}, {Header: "b", Field: "Age"}}, false},
+		{"multi name", []Column{{Header: "a", Field: "EmailAddress"}, {Header: "b", Field: "Name", Multi: true}}, false},
+	}
+	for _, tt := range tests {
+		m := &Mapping{Columns: tt.columns}
+		if err := m.Check(); (err == nil) != tt.ok {
+			t.Errorf("%s: Check returned %v, want ok = %v", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestMapping_Validate(t *testing.T) {
+	m := DefaultMapping(testDefs)
+	if err := m.Validate(testDefs); err != nil {
+		t.Errorf("Validate returned error: %v", err)
+	}
+
+	m.Columns = append(m.Columns, Column{Header: "Plan", Field: "[Plan]"})
+	err := m.Validate(testDefs)
+	var cfErr *createsend.CustomFieldError
+	if !errors.As(err, &cfErr) || cfErr.Key != "[Plan]" {
+		t.Errorf("Validate returned %v, want a *CustomFieldError for [Plan]", err)
+	}
+
+	m = &Mapping{Columns: []Column{{Header: "email", Field: "EmailAddress"}, {Header: "age", Field: "[Age]", Multi: true}}}
+	err = m.Validate(testDefs)
+	if !errors.As(err, &cfErr) || cfErr.Key != "[Age]" {
+		t.Errorf("Validate returned %v, want a *CustomFieldError for [Age]", err)
+	}
+}